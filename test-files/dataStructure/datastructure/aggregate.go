@@ -0,0 +1,68 @@
+package datastructure
+
+import "cmp"
+
+// Number 可参与求和与求平均的数值类型
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Min 返回链表中的最小值，空链表返回错误
+func Min[T cmp.Ordered](L *LinkedList[T]) (T, error) {
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	result := L.head.value
+	for current := L.head.next; current != nil; current = current.next {
+		result = min(result, current.value)
+	}
+	return result, nil
+}
+
+// Max 返回链表中的最大值，空链表返回错误
+func Max[T cmp.Ordered](L *LinkedList[T]) (T, error) {
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	result := L.head.value
+	for current := L.head.next; current != nil; current = current.next {
+		result = max(result, current.value)
+	}
+	return result, nil
+}
+
+// Sum 返回所有值之和，空链表返回 0
+func Sum[T Number](L *LinkedList[T]) T {
+	var sum T
+	for current := L.head; current != nil; current = current.next {
+		sum += current.value
+	}
+	return sum
+}
+
+// Average 返回所有值的平均数，空链表返回错误
+func Average[T Number](L *LinkedList[T]) (float64, error) {
+	if L.head == nil {
+		return 0, ErrEmptyList
+	}
+	var sum float64
+	count := 0
+	for current := L.head; current != nil; current = current.next {
+		sum += float64(current.value)
+		count++
+	}
+	return sum / float64(count), nil
+}
+
+// Histogram 一次遍历统计每个值出现的次数，空链表返回非 nil 的空 map
+func Histogram[T comparable](L *LinkedList[T]) map[T]int {
+	counts := make(map[T]int)
+	for current := L.head; current != nil; current = current.next {
+		counts[current.value]++
+	}
+	return counts
+}
@@ -0,0 +1,90 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMinMax(t *testing.T) {
+	L := newListOf(3, -7, 12, 0, -2)
+	if v, err := Min(L); err != nil || v != -7 {
+		t.Errorf("Min = (%d, %v), want (-7, nil)", v, err)
+	}
+	if v, err := Max(L); err != nil || v != 12 {
+		t.Errorf("Max = (%d, %v), want (12, nil)", v, err)
+	}
+
+	single := newListOf(-5)
+	if v, err := Min(single); err != nil || v != -5 {
+		t.Errorf("Min(single) = (%d, %v), want (-5, nil)", v, err)
+	}
+	if v, err := Max(single); err != nil || v != -5 {
+		t.Errorf("Max(single) = (%d, %v), want (-5, nil)", v, err)
+	}
+
+	words := newListOf("pear", "apple", "zoo")
+	if v, err := Min(words); err != nil || v != "apple" {
+		t.Errorf("Min(words) = (%q, %v)", v, err)
+	}
+}
+
+func TestSumAverage(t *testing.T) {
+	L := newListOf(-4, 1, 2, 5)
+	if got := Sum(L); got != 4 {
+		t.Errorf("Sum = %d, want 4", got)
+	}
+	if got, err := Average(L); err != nil || got != 1 {
+		t.Errorf("Average = (%v, %v), want (1, nil)", got, err)
+	}
+	if got, err := Average(newListOf(1, 2)); err != nil || got != 1.5 {
+		t.Errorf("Average = (%v, %v), want (1.5, nil)", got, err)
+	}
+	if got := Sum(newListOf(0.5, 0.25)); got != 0.75 {
+		t.Errorf("Sum(float) = %v, want 0.75", got)
+	}
+}
+
+func TestAggregateEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	if _, err := Min(L); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 Min 应返回错误")
+	}
+	if _, err := Max(L); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 Max 应返回错误")
+	}
+	if _, err := Average(L); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 Average 应返回错误")
+	}
+	if got := Sum(L); got != 0 {
+		t.Errorf("Sum(empty) = %d, want 0", got)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	tests := []struct {
+		name string
+		L    *LinkedList[int]
+		want map[int]int
+	}{
+		{"全部不同", newListOf(1, 2, 3), map[int]int{1: 1, 2: 1, 3: 1}},
+		{"有重复", newListOf(4, 1, 4, 4, 2, 1), map[int]int{1: 2, 2: 1, 4: 3}},
+		{"空链表", NewLinkedList[int](), map[int]int{}},
+	}
+	for _, tt := range tests {
+		got := Histogram(tt.L)
+		if got == nil {
+			t.Fatalf("%s: Histogram 不应返回 nil", tt.name)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: Histogram = %v, want %v", tt.name, got, tt.want)
+		}
+		total := 0
+		for _, n := range got {
+			total += n
+		}
+		if total != Len(tt.L) {
+			t.Fatalf("%s: 计数总和 = %d, want %d", tt.name, total, Len(tt.L))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package datastructure
+
+// NewBoundedList 创建最多保存 capacity 个元素的链表，适用于滑动窗口。
+// 插入操作使长度超过 capacity 时会自动淘汰元素：在头部插入时从尾部淘汰，其他位置插入时从头部淘汰，
+// 因此刚插入的值总会被保留（一次插入的值多于 capacity 时只保留能容纳的部分）。
+// 只在尾部追加时，链表保留最近插入的 capacity 个元素。capacity 不为正数时不限制长度。
+// 只有由 Append 触发的淘汰才会触发 EventDelete，其他插入操作本身不触发事件，其引起的淘汰也不触发
+func NewBoundedList[T any](capacity int) *LinkedList[T] {
+	L := NewLinkedList[T]()
+	L.capacity = max(capacity, 0)
+	return L
+}
+
+// Capacity 返回链表的容量上限，0 表示不限制
+func Capacity[T any](L *LinkedList[T]) int {
+	return L.capacity
+}
+
+// Evictions 返回因超出容量而淘汰的元素总数
+func Evictions[T any](L *LinkedList[T]) int {
+	return L.evictions
+}
+
+// enforceCapacity 在头部以外的位置插入后从头部淘汰超出容量的元素，不触发事件
+func enforceCapacity[T any](L *LinkedList[T]) {
+	evictOverflow(L, false, false)
+}
+
+// enforceCapacityFront 在头部插入后从尾部淘汰超出容量的元素，不触发事件
+func enforceCapacityFront[T any](L *LinkedList[T]) {
+	evictOverflow(L, true, false)
+}
+
+// evictOverflow 淘汰超出容量的元素并计入 Evictions，fromTail 为 true 时从尾部淘汰，否则从头部淘汰。
+// announce 为 true 时为每个被淘汰的值触发 EventDelete，只应由本身触发了 EventAppend 的插入传入 true，
+// 这样观察者看到的每个淘汰事件都对应一个它收到过的插入事件
+func evictOverflow[T any](L *LinkedList[T], fromTail, announce bool) {
+	if L.capacity <= 0 {
+		return
+	}
+	for L.length > L.capacity {
+		var value T
+		index := 0
+		if fromTail {
+			value, _ = PopBack(L)
+			index = L.length
+		} else {
+			value, _ = PopFront(L)
+		}
+		L.evictions++
+		if announce {
+			notify(L, EventDelete, index, value)
+		}
+	}
+}
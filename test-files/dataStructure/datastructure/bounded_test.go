@@ -0,0 +1,136 @@
+package datastructure
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBoundedListPushBack(t *testing.T) {
+	L := NewBoundedList[int](3)
+	if Capacity(L) != 3 {
+		t.Fatalf("Capacity = %d, want 3", Capacity(L))
+	}
+	var all []int
+	for i := 1; i <= 10; i++ {
+		PushBack(L, i)
+		all = append(all, i)
+		want := all[max(0, len(all)-3):]
+		if got := ToSlice(L); !reflect.DeepEqual(got, want) {
+			t.Fatalf("第 %d 次插入后 ToSlice = %v, want %v", i, got, want)
+		}
+		if Len(L) > 3 {
+			t.Fatalf("Len = %d 超过容量", Len(L))
+		}
+		validateLength(t, L)
+	}
+	if Evictions(L) != 7 {
+		t.Fatalf("Evictions = %d, want 7", Evictions(L))
+	}
+}
+
+func TestBoundedListAppend(t *testing.T) {
+	L := NewBoundedList[string](2)
+	for _, v := range []string{"a", "b", "c"} {
+		if err := Append(L, v, Len(L)); err != nil {
+			t.Fatalf("Append 失败: %v", err)
+		}
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Fatalf("ToSlice = %v, want [b c]", got)
+	}
+	AppendSlice(L, []string{"d", "e", "f"})
+	if got := ToSlice(L); !reflect.DeepEqual(got, []string{"e", "f"}) {
+		t.Fatalf("ToSlice = %v, want [e f]", got)
+	}
+	if Evictions(L) != 4 {
+		t.Fatalf("Evictions = %d, want 4", Evictions(L))
+	}
+	validateLength(t, L)
+}
+
+func TestBoundedListEvictionSide(t *testing.T) {
+	tests := []struct {
+		name      string
+		insert    func(*LinkedList[int])
+		want      []int
+		evictions int
+	}{
+		{"PushFront", func(L *LinkedList[int]) { PushFront(L, 3) }, []int{3, 1}, 1},
+		{"Prepend", func(L *LinkedList[int]) { Prepend(L, 3) }, []int{3, 1}, 1},
+		{"Append 头部", func(L *LinkedList[int]) { _ = Append(L, 3, 0) }, []int{3, 1}, 1},
+		{"InsertBefore 头节点", func(L *LinkedList[int]) { InsertBefore(L, 1, 3) }, []int{3, 1}, 1},
+		{"InsertAll 头部", func(L *LinkedList[int]) { _ = InsertAll(L, 0, []int{3, 4}) }, []int{3, 4}, 2},
+		{"InsertSorted 新的最小值", func(L *LinkedList[int]) { InsertSorted(L, 0) }, []int{0, 1}, 1},
+		{"Append 中间", func(L *LinkedList[int]) { _ = Append(L, 3, 1) }, []int{3, 2}, 1},
+		{"InsertSorted 中间", func(L *LinkedList[int]) { InsertSorted(L, 1) }, []int{1, 2}, 1},
+	}
+	for _, tt := range tests {
+		L := NewBoundedList[int](2)
+		PushBack(L, 1)
+		PushBack(L, 2)
+		tt.insert(L)
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if Evictions(L) != tt.evictions {
+			t.Fatalf("%s: Evictions = %d, want %d", tt.name, Evictions(L), tt.evictions)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestBoundedListFrontEvictionEvent(t *testing.T) {
+	L := NewBoundedList[int](2)
+	_ = Append(L, 1, 0)
+	_ = Append(L, 2, 1)
+	var events []string
+	OnChange(L, func(event string, index int, value int) {
+		events = append(events, fmt.Sprintf("%s:%d:%d", event, index, value))
+	})
+	_ = Append(L, 3, 0)
+	if want := []string{"append:0:3", "delete:2:2"}; !reflect.DeepEqual(events, want) {
+		t.Fatalf("事件 = %v, want %v", events, want)
+	}
+}
+
+func TestBoundedListEvictionEvents(t *testing.T) {
+	L := NewBoundedList[int](1)
+	var events []string
+	OnChange(L, func(event string, index int, value int) {
+		events = append(events, fmt.Sprintf("%s:%d:%d", event, index, value))
+	})
+	_ = Append(L, 1, 0)
+	_ = Append(L, 2, 1)
+	_ = Append(L, 3, 1)
+	want := []string{"append:0:1", "append:1:2", "delete:0:1", "append:1:3", "delete:0:2"}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("事件 = %v, want %v", events, want)
+	}
+}
+
+func TestBoundedListSilentEvictions(t *testing.T) {
+	L := NewBoundedList[int](1)
+	calls := 0
+	OnChange(L, func(string, int, int) { calls++ })
+	PushBack(L, 1)
+	PushBack(L, 2)
+	AppendSlice(L, []int{3, 4})
+	if calls != 0 {
+		t.Fatalf("不触发插入事件的操作引起的淘汰也不应触发事件, calls = %d", calls)
+	}
+	if Evictions(L) != 3 {
+		t.Fatalf("Evictions = %d, want 3", Evictions(L))
+	}
+}
+
+func TestUnboundedList(t *testing.T) {
+	for _, L := range []*LinkedList[int]{NewLinkedList[int](), NewBoundedList[int](0)} {
+		for i := 0; i < 100; i++ {
+			PushBack(L, i)
+		}
+		if Len(L) != 100 || Evictions(L) != 0 || Capacity(L) != 0 {
+			t.Fatalf("不限容量的链表不应淘汰元素: Len = %d, Evictions = %d", Len(L), Evictions(L))
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package datastructure
+
+// TreeNode 二叉搜索树的节点
+type TreeNode[T any] struct {
+	Value       T
+	Left, Right *TreeNode[T]
+}
+
+// ToBST 将升序排列的链表转换为高度平衡的二叉搜索树，链表为空时返回 nil。
+// 调用方需保证链表已升序排列，否则结果不满足二叉搜索树的性质。
+// 每层递归用快慢指针找 [head, end) 的中点作为根，时间复杂度 O(n log n)
+func ToBST[T any](L *LinkedList[T]) *TreeNode[T] {
+	return rangeToBST(L.head, nil)
+}
+
+// rangeToBST 将 [head, end) 区间内的节点转换为平衡二叉搜索树
+func rangeToBST[T any](head, end *node[T]) *TreeNode[T] {
+	if head == end {
+		return nil
+	}
+	slow, fast := head, head
+	for fast != end && fast.next != end {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	return &TreeNode[T]{
+		Value: slow.value,
+		Left:  rangeToBST(head, slow),
+		Right: rangeToBST(slow.next, end),
+	}
+}
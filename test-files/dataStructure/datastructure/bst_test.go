@@ -0,0 +1,49 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func inorder[T any](root *TreeNode[T], values []T) []T {
+	if root == nil {
+		return values
+	}
+	values = inorder(root.Left, values)
+	values = append(values, root.Value)
+	return inorder(root.Right, values)
+}
+
+// balancedHeight 返回树高，任一节点左右子树高度差超过 1 时返回 -1
+func balancedHeight[T any](root *TreeNode[T]) int {
+	if root == nil {
+		return 0
+	}
+	left, right := balancedHeight(root.Left), balancedHeight(root.Right)
+	if left < 0 || right < 0 || left-right > 1 || right-left > 1 {
+		return -1
+	}
+	return max(left, right) + 1
+}
+
+func TestToBST(t *testing.T) {
+	for size := 0; size <= 33; size++ {
+		L := NewLinkedList[int]()
+		for i := 0; i < size; i++ {
+			PushBack(L, i*2)
+		}
+		root := ToBST(L)
+		if got := inorder(root, []int{}); !reflect.DeepEqual(got, collect(L)) {
+			t.Fatalf("size %d: 中序遍历 = %v, want %v", size, got, collect(L))
+		}
+		if balancedHeight(root) < 0 {
+			t.Fatalf("size %d: 树不平衡", size)
+		}
+	}
+}
+
+func TestToBSTEmpty(t *testing.T) {
+	if root := ToBST(NewLinkedList[int]()); root != nil {
+		t.Fatalf("空链表应返回 nil, got %+v", root)
+	}
+}
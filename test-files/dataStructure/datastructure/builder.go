@@ -0,0 +1,29 @@
+package datastructure
+
+// Builder 以链式调用构造链表，例如 NewBuilder[int]().Add(1).AddAll(2, 3).Build()
+type Builder[T any] struct {
+	list *LinkedList[T]
+}
+
+func NewBuilder[T any]() *Builder[T] {
+	return &Builder[T]{list: NewLinkedList[T]()}
+}
+
+// Add 在尾部追加一个值
+func (B *Builder[T]) Add(value T) *Builder[T] {
+	PushBack(B.list, value)
+	return B
+}
+
+// AddAll 按顺序在尾部追加多个值
+func (B *Builder[T]) AddAll(values ...T) *Builder[T] {
+	AppendSlice(B.list, values)
+	return B
+}
+
+// Build 返回构造好的链表，之后 Builder 会重新从空链表开始
+func (B *Builder[T]) Build() *LinkedList[T] {
+	L := B.list
+	B.list = NewLinkedList[T]()
+	return L
+}
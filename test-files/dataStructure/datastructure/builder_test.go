@@ -0,0 +1,34 @@
+package datastructure
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() *LinkedList[int]
+		want  []int
+	}{
+		{"empty", func() *LinkedList[int] { return NewBuilder[int]().Build() }, []int{}},
+		{"single", func() *LinkedList[int] { return NewBuilder[int]().Add(1).Build() }, []int{1}},
+		{"add all", func() *LinkedList[int] { return NewBuilder[int]().AddAll(1, 2, 3).Build() }, []int{1, 2, 3}},
+		{"mixed", func() *LinkedList[int] {
+			return NewBuilder[int]().Add(1).AddAll(2, 3).AddAll().Add(4).Build()
+		}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		L := tt.build()
+		if !Equals(L, FromSlice(tt.want)) {
+			t.Errorf("%s: Build = %v, want %v", tt.name, L, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestBuilderReuse(t *testing.T) {
+	B := NewBuilder[string]()
+	first := B.Add("a").Build()
+	second := B.Add("b").Build()
+	if !Equals(first, FromSlice([]string{"a"})) || !Equals(second, FromSlice([]string{"b"})) {
+		t.Fatalf("Build 之后 Builder 应重新开始: %v, %v", first, second)
+	}
+}
@@ -0,0 +1,110 @@
+package datastructure
+
+// CircularList 循环单向链表，tail.next 始终指向 head，适用于轮询调度。
+// 这里的环是有意为之，HasCycle 只用于检测 LinkedList 因错误产生的环，不适用于 CircularList
+type CircularList[T any] struct {
+	head   *node[T]
+	tail   *node[T]
+	length int
+}
+
+var _ List[int] = (*CircularList[int])(nil)
+
+func NewCircularList[T any]() *CircularList[T] {
+	return new(CircularList[T])
+}
+
+// nodeAt 返回 position 处的节点，调用方需保证 position 合法
+func (C *CircularList[T]) nodeAt(position int) *node[T] {
+	current := C.head
+	for i := 0; i < position; i++ {
+		current = current.next
+	}
+	return current
+}
+
+// Append 在 position 处插入 value 并维护 tail 到 head 的回环指针，position 取值范围为 [0, length]
+func (C *CircularList[T]) Append(value T, position int) error {
+	if position < 0 || position > C.length {
+		return ErrInvalidPosition
+	}
+	newNode := &node[T]{value: value}
+	if C.head == nil {
+		newNode.next = newNode
+		C.head = newNode
+		C.tail = newNode
+		C.length++
+		return nil
+	}
+	prev := C.tail
+	if position > 0 {
+		prev = C.nodeAt(position - 1)
+	}
+	newNode.next = prev.next
+	prev.next = newNode
+	if position == 0 {
+		C.head = newNode
+	}
+	if position == C.length {
+		C.tail = newNode
+	}
+	C.length++
+	return nil
+}
+
+// DeleteNode 删除 position 处的节点并维护回环指针，position 取值范围为 [0, length)
+func (C *CircularList[T]) DeleteNode(position int) error {
+	if position < 0 || position >= C.length {
+		return ErrInvalidPosition
+	}
+	if C.length == 1 {
+		C.head = nil
+		C.tail = nil
+		C.length = 0
+		return nil
+	}
+	prev := C.tail
+	if position > 0 {
+		prev = C.nodeAt(position - 1)
+	}
+	removed := prev.next
+	prev.next = removed.next
+	if removed == C.head {
+		C.head = removed.next
+	}
+	if removed == C.tail {
+		C.tail = prev
+	}
+	C.length--
+	return nil
+}
+
+func (C *CircularList[T]) Len() int {
+	return C.length
+}
+
+// Get 返回 position 处的值，position 取值范围为 [0, length)
+func (C *CircularList[T]) Get(position int) (T, error) {
+	if position < 0 || position >= C.length {
+		var zero T
+		return zero, ErrInvalidPosition
+	}
+	return C.nodeAt(position).value, nil
+}
+
+func (C *CircularList[T]) ToSlice() []T {
+	values := make([]T, 0, C.length)
+	Each(C, func(v T) {
+		values = append(values, v)
+	})
+	return values
+}
+
+// Each 从 head 开始绕行一圈，每个节点恰好访问一次
+func Each[T any](C *CircularList[T], f func(T)) {
+	current := C.head
+	for i := 0; i < C.length; i++ {
+		f(current.value)
+		current = current.next
+	}
+}
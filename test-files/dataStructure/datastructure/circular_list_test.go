@@ -0,0 +1,131 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// validateCircular 检查循环链表的回环指针与 length 一致
+func validateCircular[T any](t *testing.T, C *CircularList[T]) {
+	t.Helper()
+	if C.length == 0 {
+		if C.head != nil || C.tail != nil {
+			t.Fatal("空循环链表的 head/tail 应为 nil")
+		}
+		return
+	}
+	if C.tail.next != C.head {
+		t.Fatal("tail.next 应指向 head")
+	}
+	current := C.head
+	for i := 1; i < C.length; i++ {
+		current = current.next
+	}
+	if current != C.tail {
+		t.Fatal("从 head 走 length-1 步应到达 tail")
+	}
+}
+
+func newCircularOf[T any](values ...T) *CircularList[T] {
+	C := NewCircularList[T]()
+	for i, v := range values {
+		if err := C.Append(v, i); err != nil {
+			panic(err)
+		}
+	}
+	return C
+}
+
+func TestCircularListEachVisitsOnce(t *testing.T) {
+	C := newCircularOf(1, 2, 3, 4)
+	seen := map[int]int{}
+	var order []int
+	Each(C, func(v int) {
+		seen[v]++
+		order = append(order, v)
+	})
+	if !reflect.DeepEqual(order, []int{1, 2, 3, 4}) {
+		t.Fatalf("Each 顺序 = %v", order)
+	}
+	for v, n := range seen {
+		if n != 1 {
+			t.Errorf("值 %d 被访问了 %d 次", v, n)
+		}
+	}
+	validateCircular(t, C)
+}
+
+func TestCircularListWraps(t *testing.T) {
+	C := newCircularOf("a", "b", "c")
+	current := C.head
+	var lap []string
+	for i := 0; i < 2*C.Len(); i++ {
+		lap = append(lap, current.value)
+		current = current.next
+	}
+	if want := []string{"a", "b", "c", "a", "b", "c"}; !reflect.DeepEqual(lap, want) {
+		t.Fatalf("绕行结果 = %v, want %v", lap, want)
+	}
+}
+
+func TestCircularListAppend(t *testing.T) {
+	C := newCircularOf(2, 3)
+	if err := C.Append(1, 0); err != nil {
+		t.Fatalf("头部插入失败: %v", err)
+	}
+	validateCircular(t, C)
+	if err := C.Append(4, C.Len()); err != nil {
+		t.Fatalf("尾部插入失败: %v", err)
+	}
+	validateCircular(t, C)
+	if err := C.Append(9, 2); err != nil {
+		t.Fatalf("中间插入失败: %v", err)
+	}
+	validateCircular(t, C)
+	if got, want := C.ToSlice(), []int{1, 2, 9, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	if err := C.Append(0, -1); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("无效位置应返回错误")
+	}
+}
+
+func TestCircularListDeleteHead(t *testing.T) {
+	C := newCircularOf(1, 2, 3)
+	if err := C.DeleteNode(0); err != nil {
+		t.Fatalf("删除头节点失败: %v", err)
+	}
+	if C.head.value != 2 || C.tail.next != C.head {
+		t.Fatal("删除头节点后 tail 应回环到新的 head")
+	}
+	validateCircular(t, C)
+
+	if err := C.DeleteNode(C.Len() - 1); err != nil {
+		t.Fatalf("删除尾节点失败: %v", err)
+	}
+	if C.tail.value != 2 || C.tail.next != C.head {
+		t.Fatal("删除尾节点后回环指针错误")
+	}
+	validateCircular(t, C)
+
+	if err := C.DeleteNode(0); err != nil {
+		t.Fatalf("删除唯一节点失败: %v", err)
+	}
+	validateCircular(t, C)
+	if err := C.DeleteNode(0); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("空链表删除应返回错误")
+	}
+}
+
+func TestCircularListGet(t *testing.T) {
+	C := newCircularOf(5, 6, 7)
+	for i, want := range []int{5, 6, 7} {
+		if v, err := C.Get(i); err != nil || v != want {
+			t.Errorf("Get(%d) = (%d, %v), want (%d, nil)", i, v, err, want)
+		}
+	}
+	if _, err := C.Get(3); !errors.Is(err, ErrInvalidPosition) {
+		t.Error("越界 Get 应返回错误")
+	}
+}
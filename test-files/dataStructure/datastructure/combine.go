@@ -0,0 +1,160 @@
+package datastructure
+
+import "fmt"
+
+// Concat 返回 a 的元素后接 b 的元素组成的新链表，不修改输入
+func Concat[T any](a, b *LinkedList[T]) *LinkedList[T] {
+	result := Clone(a)
+	for current := b.head; current != nil; current = current.next {
+		PushBack(result, current.value)
+	}
+	return result
+}
+
+// Split 返回 [0, position) 与 [position, length) 两个独立的新链表，position 取值范围为 [0, length]
+func Split[T any](L *LinkedList[T], position int) (*LinkedList[T], *LinkedList[T], error) {
+	if position < 0 || position > L.length {
+		return nil, nil, ErrInvalidPosition
+	}
+	prefix, suffix := NewLinkedList[T](), NewLinkedList[T]()
+	index := 0
+	for current := L.head; current != nil; current = current.next {
+		if index < position {
+			PushBack(prefix, current.value)
+		} else {
+			PushBack(suffix, current.value)
+		}
+		index++
+	}
+	return prefix, suffix, nil
+}
+
+// CopyRange 将 [start, end) 内的值复制到新链表，不修改原链表，要求 0 <= start <= end <= length
+func CopyRange[T any](L *LinkedList[T], start, end int) (*LinkedList[T], error) {
+	if start < 0 || start > end || end > L.length {
+		return nil, fmt.Errorf("%w: [%d, %d)", ErrInvalidPosition, start, end)
+	}
+	result := NewLinkedList[T]()
+	if start == end {
+		return result, nil
+	}
+	current := nodeAt(L, start)
+	for i := start; i < end; i++ {
+		PushBack(result, current.value)
+		current = current.next
+	}
+	return result, nil
+}
+
+// Pair Zip 产生的一对值
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip 将两个链表按位置配对，长度不同时截断到较短的链表
+func Zip[A, B any](a *LinkedList[A], b *LinkedList[B]) []Pair[A, B] {
+	pairs := make([]Pair[A, B], 0, min(a.length, b.length))
+	for x, y := a.head, b.head; x != nil && y != nil; x, y = x.next, y.next {
+		pairs = append(pairs, Pair[A, B]{First: x.value, Second: y.value})
+	}
+	return pairs
+}
+
+// ZipStrict 与 Zip 相同，但两个链表长度不同时返回 ErrLengthMismatch
+func ZipStrict[A, B any](a *LinkedList[A], b *LinkedList[B]) ([]Pair[A, B], error) {
+	if a.length != b.length {
+		return nil, fmt.Errorf("%w: %d != %d", ErrLengthMismatch, a.length, b.length)
+	}
+	return Zip(a, b), nil
+}
+
+// Unzip 将配对拆分为两个链表
+func Unzip[A, B any](pairs []Pair[A, B]) (*LinkedList[A], *LinkedList[B]) {
+	firsts, seconds := NewLinkedList[A](), NewLinkedList[B]()
+	for _, p := range pairs {
+		PushBack(firsts, p.First)
+		PushBack(seconds, p.Second)
+	}
+	return firsts, seconds
+}
+
+// Chunk 按顺序将链表切分为若干个最多包含 size 个元素的独立链表，最后一块可能更短
+func Chunk[T any](L *LinkedList[T], size int) ([]*LinkedList[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	chunks := make([]*LinkedList[T], 0, (L.length+size-1)/size)
+	var chunk *LinkedList[T]
+	for current := L.head; current != nil; current = current.next {
+		if chunk == nil || chunk.length == size {
+			chunk = NewLinkedList[T]()
+			chunks = append(chunks, chunk)
+		}
+		PushBack(chunk, current.value)
+	}
+	return chunks, nil
+}
+
+// Windows 返回所有长度为 size 的连续窗口，每次向后滑动一个元素，共 length-size+1 个。
+// 链表长度小于 size 时返回空结果
+func Windows[T any](L *LinkedList[T], size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	windows := make([][]T, 0, max(L.length-size+1, 0))
+	start := L.head
+	for i := 0; i+size <= L.length; i++ {
+		window := make([]T, 0, size)
+		for current, k := start, 0; k < size; current, k = current.next, k+1 {
+			window = append(window, current.value)
+		}
+		windows = append(windows, window)
+		start = start.next
+	}
+	return windows, nil
+}
+
+// Flatten 按顺序将多个链表拼接为一个新链表，跳过 nil 或空链表，不修改输入
+func Flatten[T any](lists []*LinkedList[T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for _, L := range lists {
+		if L == nil {
+			continue
+		}
+		for current := L.head; current != nil; current = current.next {
+			PushBack(result, current.value)
+		}
+	}
+	return result
+}
+
+// Interleave 返回交替取 a、b 元素的新链表 a[0], b[0], a[1], b[1], ...，
+// 较短的链表取完后追加较长链表的剩余元素，不修改输入
+func Interleave[T any](a, b *LinkedList[T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	x, y := a.head, b.head
+	for x != nil || y != nil {
+		if x != nil {
+			PushBack(result, x.value)
+			x = x.next
+		}
+		if y != nil {
+			PushBack(result, y.value)
+			y = y.next
+		}
+	}
+	return result
+}
+
+// Intersperse 返回在每对相邻元素之间插入 sep 的新链表，首尾不插入，不修改原链表
+func Intersperse[T any](L *LinkedList[T], sep T) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for current := L.head; current != nil; current = current.next {
+		if current != L.head {
+			PushBack(result, sep)
+		}
+		PushBack(result, current.value)
+	}
+	return result
+}
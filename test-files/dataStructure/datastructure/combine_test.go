@@ -0,0 +1,293 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	a, b := newListOf(1, 2), newListOf(3, 4, 5)
+	joined := Concat(a, b)
+	if got, want := ToSlice(joined), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Concat = %v, want %v", got, want)
+	}
+	validateLength(t, joined)
+	if Len(a) != 2 || Len(b) != 3 || a.tail.next != nil {
+		t.Fatal("Concat 不应修改输入")
+	}
+
+	if got := ToSlice(Concat(NewLinkedList[int](), b)); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Fatalf("Concat(empty, b) = %v", got)
+	}
+	if got := ToSlice(Concat(a, NewLinkedList[int]())); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("Concat(a, empty) = %v", got)
+	}
+}
+
+func TestSplitRoundTrip(t *testing.T) {
+	L := Concat(newListOf(1, 2, 3), newListOf(4, 5))
+	for position := 0; position <= Len(L); position++ {
+		prefix, suffix, err := Split(L, position)
+		if err != nil {
+			t.Fatalf("Split(%d) 返回错误: %v", position, err)
+		}
+		if Len(prefix) != position || Len(suffix) != Len(L)-position {
+			t.Fatalf("Split(%d) 长度错误: %d, %d", position, Len(prefix), Len(suffix))
+		}
+		validateLength(t, prefix)
+		validateLength(t, suffix)
+		if !Equals(Concat(prefix, suffix), L) {
+			t.Fatalf("Split(%d) 后重新拼接应与原链表相等", position)
+		}
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Split 不应修改原链表: %v", got)
+	}
+}
+
+func TestSplitInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, position := range []int{-1, 4} {
+		if _, _, err := Split(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Split(%d) 应返回错误", position)
+		}
+	}
+}
+
+func TestCopyRange(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5)
+	tests := []struct {
+		start, end int
+		want       []int
+	}{
+		{0, 5, []int{1, 2, 3, 4, 5}},
+		{1, 4, []int{2, 3, 4}},
+		{2, 2, []int{}},
+		{4, 5, []int{5}},
+	}
+	for _, tt := range tests {
+		sub, err := CopyRange(L, tt.start, tt.end)
+		if err != nil {
+			t.Fatalf("CopyRange(%d, %d) 返回错误: %v", tt.start, tt.end, err)
+		}
+		if got := ToSlice(sub); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("CopyRange(%d, %d) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+		validateLength(t, sub)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("CopyRange 不应修改原链表: %v", got)
+	}
+}
+
+func TestCopyRangeInvalid(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, r := range [][2]int{{-1, 2}, {2, 1}, {0, 4}, {4, 4}} {
+		if _, err := CopyRange(L, r[0], r[1]); !errors.Is(err, ErrInvalidPosition) {
+			t.Fatalf("CopyRange(%d, %d) 错误 = %v, want ErrInvalidPosition", r[0], r[1], err)
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	a, b := newListOf(1, 2, 3), newListOf("a", "b", "c")
+	want := []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+	if got := Zip(a, b); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Zip = %v, want %v", got, want)
+	}
+	strict, err := ZipStrict(a, b)
+	if err != nil || !reflect.DeepEqual(strict, want) {
+		t.Fatalf("ZipStrict = (%v, %v), want (%v, nil)", strict, err, want)
+	}
+}
+
+func TestZipUnequal(t *testing.T) {
+	a, b := newListOf(1, 2, 3, 4), newListOf(10, 20)
+	want := []Pair[int, int]{{1, 10}, {2, 20}}
+	if got := Zip(a, b); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Zip = %v, want %v", got, want)
+	}
+	if got := Zip(b, NewLinkedList[int]()); len(got) != 0 {
+		t.Fatalf("Zip(b, empty) = %v, want []", got)
+	}
+	if _, err := ZipStrict(a, b); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("ZipStrict err = %v, want ErrLengthMismatch", err)
+	}
+}
+
+func TestUnzipRoundTrip(t *testing.T) {
+	a, b := newListOf(1, 2, 3), newListOf(point{1, 1}, point{2, 2}, point{3, 3})
+	firsts, seconds := Unzip(Zip(a, b))
+	if !Equals(firsts, a) || !Equals(seconds, b) {
+		t.Fatalf("Unzip = %v, %v", firsts, seconds)
+	}
+	validateLength(t, firsts)
+	validateLength(t, seconds)
+
+	emptyA, emptyB := Unzip[int, int](nil)
+	if Len(emptyA) != 0 || Len(emptyB) != 0 {
+		t.Fatal("Unzip(nil) 应返回两个空链表")
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		size   int
+		want   [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"smaller than size", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty", []int{}, 3, [][]int{}},
+	}
+	for _, tt := range tests {
+		chunks, err := Chunk(FromSlice(tt.values), tt.size)
+		if err != nil {
+			t.Fatalf("%s: Chunk 返回错误: %v", tt.name, err)
+		}
+		got := make([][]int, 0, len(chunks))
+		for _, c := range chunks {
+			validateLength(t, c)
+			got = append(got, ToSlice(c))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Chunk = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		if _, err := Chunk(newListOf(1), size); !errors.Is(err, ErrInvalidSize) {
+			t.Errorf("Chunk(size=%d) err = %v, want ErrInvalidSize", size, err)
+		}
+	}
+}
+
+func TestWindows(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5)
+	tests := []struct {
+		size int
+		want [][]int
+	}{
+		{1, [][]int{{1}, {2}, {3}, {4}, {5}}},
+		{2, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{3, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{5, [][]int{{1, 2, 3, 4, 5}}},
+		{6, [][]int{}},
+	}
+	for _, tt := range tests {
+		windows, err := Windows(L, tt.size)
+		if err != nil {
+			t.Fatalf("Windows(size=%d) 返回错误: %v", tt.size, err)
+		}
+		if !reflect.DeepEqual(windows, tt.want) {
+			t.Fatalf("Windows(size=%d) = %v, want %v", tt.size, windows, tt.want)
+		}
+		if want := max(Len(L)-tt.size+1, 0); len(windows) != want {
+			t.Fatalf("Windows(size=%d) 窗口数 = %d, want %d", tt.size, len(windows), want)
+		}
+	}
+	if windows, err := Windows(NewLinkedList[int](), 2); err != nil || len(windows) != 0 {
+		t.Fatalf("空链表 Windows = (%v, %v)", windows, err)
+	}
+}
+
+func TestWindowsInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		if _, err := Windows(newListOf(1, 2), size); !errors.Is(err, ErrInvalidSize) {
+			t.Errorf("Windows(size=%d) err = %v, want ErrInvalidSize", size, err)
+		}
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	lists := []*LinkedList[int]{
+		NewLinkedList[int](),
+		newListOf(1, 2),
+		nil,
+		newListOf(3),
+		NewLinkedList[int](),
+		newListOf(4, 5, 6),
+	}
+	flat := Flatten(lists)
+	if got, want := ToSlice(flat), []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Flatten = %v, want %v", got, want)
+	}
+	total := 0
+	for _, L := range lists {
+		if L != nil {
+			total += Len(L)
+		}
+	}
+	if Len(flat) != total {
+		t.Fatalf("Len = %d, want %d", Len(flat), total)
+	}
+	validateLength(t, flat)
+	if got := ToSlice(lists[1]); !reflect.DeepEqual(got, []int{1, 2}) || lists[1].tail.next != nil {
+		t.Fatal("Flatten 不应修改输入")
+	}
+}
+
+func TestFlattenEmpty(t *testing.T) {
+	for _, lists := range [][]*LinkedList[string]{nil, {}, {nil, NewLinkedList[string]()}} {
+		flat := Flatten(lists)
+		if !ListIsEmpty(flat) || flat.tail != nil {
+			t.Fatalf("Flatten(%v) 应为空", lists)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{"equal", []int{1, 3, 5}, []int{2, 4, 6}, []int{1, 2, 3, 4, 5, 6}},
+		{"longer first", []int{1, 3, 5, 7}, []int{2}, []int{1, 2, 3, 5, 7}},
+		{"longer second", []int{1}, []int{2, 4, 6}, []int{1, 2, 4, 6}},
+		{"a empty", []int{}, []int{2, 4}, []int{2, 4}},
+		{"b empty", []int{1, 3}, []int{}, []int{1, 3}},
+	}
+	for _, tt := range tests {
+		a, b := FromSlice(tt.a), FromSlice(tt.b)
+		result := Interleave(a, b)
+		if got := ToSlice(result); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Interleave = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, result)
+		if !reflect.DeepEqual(ToSlice(a), tt.a) || !reflect.DeepEqual(ToSlice(b), tt.b) {
+			t.Errorf("%s: Interleave 不应修改输入", tt.name)
+		}
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 0, 2, 0, 3}},
+		{[]int{1, 2}, []int{1, 0, 2}},
+		{[]int{1}, []int{1}},
+		{nil, []int{}},
+	}
+	for _, tt := range tests {
+		L := newListOf(tt.values...)
+		result := Intersperse(L, 0)
+		if got := collect(result); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("Intersperse(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+		if n := Len(L); n >= 1 && Len(result) != 2*n-1 {
+			t.Fatalf("Intersperse(%v) 长度 = %d, want %d", tt.values, Len(result), 2*n-1)
+		}
+		validateLength(t, result)
+		if Len(L) != len(tt.values) {
+			t.Fatal("Intersperse 不应修改原链表")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package datastructure
+
+import "cmp"
+
+// Equals 判断两个链表是否长度相同且按顺序逐个元素相等。
+// 两个 nil 视为相等，nil 与非 nil 不相等
+func Equals[T comparable](a, b *LinkedList[T]) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.length != b.length {
+		return false
+	}
+	for x, y := a.head, b.head; x != nil && y != nil; x, y = x.next, y.next {
+		if x.value != y.value {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc 与 Equals 相同，但使用 eq 比较元素，因此不要求 T 满足 comparable
+func EqualFunc[T any](a, b *LinkedList[T], eq func(x, y T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.length != b.length {
+		return false
+	}
+	for x, y := a.head, b.head; x != nil && y != nil; x, y = x.next, y.next {
+		if !eq(x.value, y.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareTo 按字典序比较两个链表，a 小于、等于、大于 b 时分别返回负数、0、正数。
+// 同时遍历两个链表并在第一个不同的元素处停止，较短的前缀排在前面
+func CompareTo[T cmp.Ordered](a, b *LinkedList[T]) int {
+	x, y := a.head, b.head
+	for x != nil && y != nil {
+		if c := cmp.Compare(x.value, y.value); c != 0 {
+			return c
+		}
+		x, y = x.next, y.next
+	}
+	switch {
+	case x == nil && y == nil:
+		return 0
+	case x == nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// EqualsRotated 判断 b 是否为 a 的某个旋转，即长度相同且 b 的序列是 a 首尾相接后的一段连续子序列。
+// 在 a+a 上用 KMP 查找 b，时间复杂度 O(n)。两个空链表视为相等
+func EqualsRotated[T comparable](a, b *LinkedList[T]) bool {
+	if a.length != b.length {
+		return false
+	}
+	if a.length == 0 {
+		return true
+	}
+	x, pattern := ToSlice(a), ToSlice(b)
+	text := append(x, x[:len(x)-1]...)
+
+	// fail[i] 为 pattern[:i+1] 最长的相等真前缀与真后缀的长度
+	fail := make([]int, len(pattern))
+	for i, k := 1, 0; i < len(pattern); i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = fail[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		fail[i] = k
+	}
+	for i, k := 0, 0; i < len(text); i++ {
+		for k > 0 && text[i] != pattern[k] {
+			k = fail[k-1]
+		}
+		if text[i] == pattern[k] {
+			k++
+		}
+		if k == len(pattern) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package datastructure
+
+import "testing"
+
+func TestEquals(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *LinkedList[int]
+		want bool
+	}{
+		{"equal", newListOf(1, 2, 3), newListOf(1, 2, 3), true},
+		{"different length", newListOf(1, 2, 3), newListOf(1, 2), false},
+		{"different values", newListOf(1, 2, 3), newListOf(1, 5, 3), false},
+		{"both empty", NewLinkedList[int](), NewLinkedList[int](), true},
+		{"empty vs non-empty", NewLinkedList[int](), newListOf(1), false},
+		{"both nil", nil, nil, true},
+		{"nil vs empty", nil, NewLinkedList[int](), false},
+		{"non-empty vs nil", newListOf(1), nil, false},
+	}
+	for _, tt := range tests {
+		if got := Equals(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: Equals = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEqualsSameList(t *testing.T) {
+	L := newListOf("a", "b")
+	if !Equals(L, L) {
+		t.Fatal("链表应与自身相等")
+	}
+}
+
+// record 含切片字段，不满足 comparable
+type record struct {
+	ID   int
+	Tags []string
+}
+
+func sameID(x, y record) bool {
+	return x.ID == y.ID
+}
+
+func TestEqualFunc(t *testing.T) {
+	a := newListOf(record{1, []string{"x"}}, record{2, nil})
+	b := newListOf(record{1, []string{"y", "z"}}, record{2, []string{"w"}})
+	if !EqualFunc(a, b, sameID) {
+		t.Fatal("按 ID 比较时应相等")
+	}
+	c := newListOf(record{1, nil}, record{3, nil})
+	if EqualFunc(a, c, sameID) {
+		t.Fatal("ID 不同时应不相等")
+	}
+	if EqualFunc(a, newListOf(record{1, nil}), sameID) {
+		t.Fatal("长度不同时应不相等")
+	}
+	if !EqualFunc[record](nil, nil, sameID) || EqualFunc(a, nil, sameID) {
+		t.Fatal("nil 比较结果错误")
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	L := newListOf(record{1, []string{"a"}}, record{2, []string{"b", "c"}})
+	if !ContainsFunc(L, func(r record) bool { return len(r.Tags) == 2 }) {
+		t.Fatal("应找到含两个标签的记录")
+	}
+	if ContainsFunc(L, func(r record) bool { return r.ID == 3 }) {
+		t.Fatal("不应找到 ID 为 3 的记录")
+	}
+	if ContainsFunc(NewLinkedList[record](), func(record) bool { return true }) {
+		t.Fatal("空链表应返回 false")
+	}
+}
+
+func TestCompareTo(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *LinkedList[int]
+		want int
+	}{
+		{"equal", newListOf(1, 2, 3), newListOf(1, 2, 3), 0},
+		{"both empty", NewLinkedList[int](), NewLinkedList[int](), 0},
+		{"prefix", newListOf(1, 2), newListOf(1, 2, 3), -1},
+		{"longer", newListOf(1, 2, 3), newListOf(1, 2), 1},
+		{"empty vs non-empty", NewLinkedList[int](), newListOf(1), -1},
+		{"first element", newListOf(2, 0, 0), newListOf(1, 9, 9), 1},
+		{"middle element", newListOf(1, 2, 9), newListOf(1, 3, 0), -1},
+	}
+	for _, tt := range tests {
+		got := CompareTo(tt.a, tt.b)
+		if (got < 0) != (tt.want < 0) || (got > 0) != (tt.want > 0) {
+			t.Errorf("%s: CompareTo = %d, want sign %d", tt.name, got, tt.want)
+		}
+		if back := CompareTo(tt.b, tt.a); (back < 0) != (got > 0) || (back > 0) != (got < 0) {
+			t.Errorf("%s: CompareTo 不满足反对称性: %d, %d", tt.name, got, back)
+		}
+	}
+}
+
+func TestEqualsRotated(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *LinkedList[int]
+		want bool
+	}{
+		{"rotation", newListOf(1, 2, 3, 4), newListOf(3, 4, 1, 2), true},
+		{"same order", newListOf(1, 2, 3), newListOf(1, 2, 3), true},
+		{"rotation with repeats", newListOf(1, 1, 2, 1), newListOf(1, 1, 1, 2), true},
+		{"reversed", newListOf(1, 2, 3, 4), newListOf(4, 3, 2, 1), false},
+		{"different multiset", newListOf(1, 2, 3), newListOf(1, 2, 4), false},
+		{"different length", newListOf(1, 2, 3), newListOf(1, 2), false},
+		{"single", newListOf(5), newListOf(5), true},
+		{"both empty", NewLinkedList[int](), NewLinkedList[int](), true},
+	}
+	for _, tt := range tests {
+		if got := EqualsRotated(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: EqualsRotated = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEqualsRotatedAllRotations(t *testing.T) {
+	a := newListOf(1, 2, 1, 3, 1)
+	for k := 0; k < Len(a); k++ {
+		b := Clone(a)
+		Rotate(b, k)
+		if !EqualsRotated(a, b) || !EqualsRotated(b, a) {
+			t.Errorf("左旋 %d 位后 EqualsRotated 应为 true: %v vs %v", k, a, b)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package datastructure
+
+import "sync"
+
+// ConcurrentList 并发安全的链表，写操作持有写锁，读操作持有读锁
+type ConcurrentList[T any] struct {
+	mu   sync.RWMutex
+	list *LinkedList[T]
+}
+
+func NewConcurrentList[T any]() *ConcurrentList[T] {
+	return &ConcurrentList[T]{list: NewLinkedList[T]()}
+}
+
+func (C *ConcurrentList[T]) Append(value T, position int) error {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	return Append(C.list, value, position)
+}
+
+func (C *ConcurrentList[T]) DeleteNode(position int) error {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	return DeleteNode(C.list, position)
+}
+
+func (C *ConcurrentList[T]) Set(position int, value T) error {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	return Set(C.list, position, value)
+}
+
+func (C *ConcurrentList[T]) PushFront(value T) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	PushFront(C.list, value)
+}
+
+func (C *ConcurrentList[T]) PushBack(value T) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	PushBack(C.list, value)
+}
+
+func (C *ConcurrentList[T]) PopFront() (T, error) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	return PopFront(C.list)
+}
+
+func (C *ConcurrentList[T]) PopBack() (T, error) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	return PopBack(C.list)
+}
+
+func (C *ConcurrentList[T]) Reverse() {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+	Reverse(C.list)
+}
+
+func (C *ConcurrentList[T]) Get(position int) (T, error) {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return Get(C.list, position)
+}
+
+func (C *ConcurrentList[T]) Len() int {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return Len(C.list)
+}
+
+// IsEmpty 持有读锁判断链表是否为空，可与写操作并发调用
+func (C *ConcurrentList[T]) IsEmpty() bool {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return ListIsEmpty(C.list)
+}
+
+func (C *ConcurrentList[T]) ToSlice() []T {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return ToSlice(C.list)
+}
+
+func (C *ConcurrentList[T]) String() string {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return C.list.String()
+}
+
+// Snapshot 在短暂持有读锁期间复制所有值，调用方可以在不持有锁的情况下遍历返回的切片。
+// 返回的是调用时刻的视图，之后的写操作不会反映到其中
+func (C *ConcurrentList[T]) Snapshot() []T {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return ToSlice(C.list)
+}
+
+// SnapshotList 与 Snapshot 相同，但返回一个与 C 不共享节点的独立链表
+func (C *ConcurrentList[T]) SnapshotList() *LinkedList[T] {
+	C.mu.RLock()
+	defer C.mu.RUnlock()
+	return Clone(C.list)
+}
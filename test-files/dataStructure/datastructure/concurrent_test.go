@@ -0,0 +1,199 @@
+package datastructure
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentListAppendDelete(t *testing.T) {
+	const (
+		workers    = 16
+		perWorker  = 200
+		deletesPer = 50
+	)
+	C := NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if err := C.Append(w*perWorker+i, 0); err != nil {
+					t.Errorf("Append 失败: %v", err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < deletesPer; i++ {
+				if err := C.DeleteNode(0); err != nil {
+					t.Errorf("DeleteNode 失败: %v", err)
+					return
+				}
+				_, _ = C.Get(0)
+				_ = C.ToSlice()
+			}
+		}()
+	}
+	wg.Wait()
+
+	want := workers * (perWorker - deletesPer)
+	if got := C.Len(); got != want {
+		t.Fatalf("Len = %d, want %d", got, want)
+	}
+	if got := len(C.ToSlice()); got != want {
+		t.Fatalf("len(ToSlice) = %d, want %d", got, want)
+	}
+	validateLength(t, C.list)
+}
+
+func TestConcurrentListMixed(t *testing.T) {
+	C := NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				C.PushBack(i)
+				C.PushFront(i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = C.Len()
+				_, _ = C.Get(0)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := C.Len(); got != 1600 {
+		t.Fatalf("Len = %d, want 1600", got)
+	}
+	validateLength(t, C.list)
+}
+
+func TestConcurrentListSnapshot(t *testing.T) {
+	const (
+		writers   = 4
+		perWriter = 500
+	)
+	C := NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				C.PushBack(i)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		last := 0
+		for i := 0; i < 100; i++ {
+			snapshot := C.Snapshot()
+			if len(snapshot) < last {
+				t.Errorf("快照长度减少: %d < %d", len(snapshot), last)
+				return
+			}
+			last = len(snapshot)
+			for _, v := range snapshot {
+				if v < 0 || v >= perWriter {
+					t.Errorf("快照中出现意外的值 %d", v)
+					return
+				}
+			}
+
+			L := C.SnapshotList()
+			if Len(L) < last {
+				t.Errorf("SnapshotList 长度 %d 小于之前的快照 %d", Len(L), last)
+				return
+			}
+			validateLength(t, L)
+		}
+	}()
+	wg.Wait()
+	<-done
+
+	snapshot := C.Snapshot()
+	if len(snapshot) != writers*perWriter {
+		t.Fatalf("len(Snapshot) = %d, want %d", len(snapshot), writers*perWriter)
+	}
+	C.PushBack(-1)
+	if len(snapshot) != writers*perWriter {
+		t.Fatal("快照不应受之后写操作的影响")
+	}
+	L := C.SnapshotList()
+	PushBack(L, -2)
+	if C.Len() != writers*perWriter+1 {
+		t.Fatal("修改 SnapshotList 的结果不应影响原链表")
+	}
+}
+
+func TestConcurrentListLenIsEmpty(t *testing.T) {
+	const (
+		writers   = 4
+		perWriter = 300
+		readers   = 8
+		maxLen    = writers * perWriter
+	)
+	C := NewConcurrentList[int]()
+	var writersDone sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writersDone.Add(1)
+		go func() {
+			defer writersDone.Done()
+			for i := 0; i < perWriter; i++ {
+				C.PushBack(i)
+			}
+			for i := 0; i < perWriter; i++ {
+				if _, err := C.PopFront(); err != nil {
+					t.Errorf("PopFront 失败: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	var readersDone sync.WaitGroup
+	for r := 0; r < readers; r++ {
+		readersDone.Add(1)
+		go func() {
+			defer readersDone.Done()
+			for i := 0; i < 200; i++ {
+				n := C.Len()
+				if n < 0 || n > maxLen {
+					t.Errorf("Len = %d 超出范围 [0, %d]", n, maxLen)
+					return
+				}
+				if s := len(C.Snapshot()); s < 0 || s > maxLen {
+					t.Errorf("len(Snapshot) = %d 超出范围 [0, %d]", s, maxLen)
+					return
+				}
+				_ = C.IsEmpty()
+			}
+		}()
+	}
+	writersDone.Wait()
+	readersDone.Wait()
+
+	if !C.IsEmpty() || C.Len() != 0 {
+		t.Fatalf("写入并全部删除后 IsEmpty = %v, Len = %d", C.IsEmpty(), C.Len())
+	}
+	C.PushBack(1)
+	if C.IsEmpty() || C.Len() != 1 || len(C.Snapshot()) != 1 {
+		t.Fatal("插入一个元素后 IsEmpty、Len 与 Snapshot 应一致")
+	}
+	validateLength(t, C.list)
+}
@@ -0,0 +1,64 @@
+package datastructure
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// ToSlice 按从头到尾的顺序返回链表中的值，空链表返回非 nil 的空切片
+func ToSlice[T any](L *LinkedList[T]) []T {
+	values := make([]T, 0, L.length)
+	for current := L.head; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return values
+}
+
+// FromSlice 按 values 的顺序构造新链表
+func FromSlice[T any](values []T) *LinkedList[T] {
+	L := NewLinkedList[T]()
+	for _, value := range values {
+		newNode := &node[T]{value: value}
+		if L.tail == nil {
+			L.head = newNode
+		} else {
+			L.tail.next = newNode
+		}
+		L.tail = newNode
+		L.length++
+	}
+	return L
+}
+
+// FromChannel 持续从 ch 接收值并按到达顺序借助 tail 指针追加到新链表，直到 ch 被关闭
+func FromChannel[T any](ch <-chan T) *LinkedList[T] {
+	L := NewLinkedList[T]()
+	for value := range ch {
+		PushBack(L, value)
+	}
+	return L
+}
+
+// FromStdList 将 container/list 转换为链表，元素类型不是 T 时返回 ErrTypeMismatch
+func FromStdList[T any](l *list.List) (*LinkedList[T], error) {
+	L := NewLinkedList[T]()
+	index := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		value, ok := e.Value.(T)
+		if !ok {
+			return nil, fmt.Errorf("%w: 第 %d 个元素的类型为 %T", ErrTypeMismatch, index, e.Value)
+		}
+		PushBack(L, value)
+		index++
+	}
+	return L, nil
+}
+
+// ToStdList 将链表转换为 container/list
+func ToStdList[T any](L *LinkedList[T]) *list.List {
+	l := list.New()
+	for current := L.head; current != nil; current = current.next {
+		l.PushBack(current.value)
+	}
+	return l
+}
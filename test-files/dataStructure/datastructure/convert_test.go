@@ -0,0 +1,126 @@
+package datastructure
+
+import (
+	"container/list"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestToSlice(t *testing.T) {
+	if got := ToSlice(NewLinkedList[int]()); got == nil || len(got) != 0 {
+		t.Fatalf("ToSlice(empty) = %#v, want 非 nil 空切片", got)
+	}
+	L := newListOf(3, 1, 2)
+	if got, want := ToSlice(L), []int{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+}
+
+func TestFromSliceRoundTrip(t *testing.T) {
+	for _, values := range [][]int{{1}, {1, 2}, {4, 3, 2, 1, 0}} {
+		L := FromSlice(values)
+		validateLength(t, L)
+		if got := ToSlice(L); !reflect.DeepEqual(got, values) {
+			t.Errorf("ToSlice(FromSlice(%v)) = %v", values, got)
+		}
+		if L.head.value != values[0] || L.tail.value != values[len(values)-1] {
+			t.Errorf("FromSlice(%v) head/tail 错误", values)
+		}
+	}
+}
+
+func TestFromSliceNil(t *testing.T) {
+	L := FromSlice[string](nil)
+	if !ListIsEmpty(L) || L.tail != nil || Len(L) != 0 {
+		t.Fatalf("FromSlice(nil) 应返回空链表: %+v", L)
+	}
+	if err := Append(L, "a", 0); err != nil {
+		t.Fatalf("空链表插入失败: %v", err)
+	}
+	validateLength(t, L)
+}
+
+func TestStdListRoundTrip(t *testing.T) {
+	L := newListOf(3, 1, 2)
+	l := ToStdList(L)
+	if l.Len() != 3 {
+		t.Fatalf("ToStdList Len = %d, want 3", l.Len())
+	}
+	var fromStd []int
+	for e := l.Front(); e != nil; e = e.Next() {
+		fromStd = append(fromStd, e.Value.(int))
+	}
+	if !reflect.DeepEqual(fromStd, []int{3, 1, 2}) {
+		t.Fatalf("ToStdList 顺序 = %v", fromStd)
+	}
+
+	back, err := FromStdList[int](l)
+	if err != nil {
+		t.Fatalf("FromStdList 返回错误: %v", err)
+	}
+	if !Equals(back, L) {
+		t.Fatalf("往返后 = %v, want %v", back, L)
+	}
+	validateLength(t, back)
+}
+
+func TestStdListEmpty(t *testing.T) {
+	if l := ToStdList(NewLinkedList[int]()); l.Len() != 0 {
+		t.Fatalf("ToStdList(empty) Len = %d", l.Len())
+	}
+	L, err := FromStdList[int](list.New())
+	if err != nil {
+		t.Fatalf("FromStdList 返回错误: %v", err)
+	}
+	if !ListIsEmpty(L) || Len(L) != 0 {
+		t.Fatalf("FromStdList(empty) 应为空: %+v", L)
+	}
+}
+
+func TestFromStdListTypeMismatch(t *testing.T) {
+	l := list.New()
+	l.PushBack(1)
+	l.PushBack("two")
+	if _, err := FromStdList[int](l); !errors.Is(err, ErrTypeMismatch) {
+		t.Fatalf("err = %v, want ErrTypeMismatch", err)
+	}
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 4)
+	for _, v := range []int{3, 1, 4, 1} {
+		ch <- v
+	}
+	close(ch)
+	L := FromChannel(ch)
+	if got, want := ToSlice(L), []int{3, 1, 4, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("FromChannel = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestFromChannelProducer(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; i < 100; i++ {
+			ch <- i
+		}
+	}()
+	L := FromChannel(ch)
+	if Len(L) != 100 || L.head.value != 0 || L.tail.value != 99 {
+		t.Fatalf("FromChannel 结果错误: len %d", Len(L))
+	}
+	validateLength(t, L)
+}
+
+func TestFromChannelEmpty(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	L := FromChannel(ch)
+	if got := ToSlice(L); len(got) != 0 {
+		t.Fatalf("已关闭的空 channel 应得到空链表, got %v", got)
+	}
+	validateLength(t, L)
+}
@@ -0,0 +1,48 @@
+package datastructure
+
+// HasCycle 使用 Floyd 快慢指针判断链表是否成环，时间复杂度 O(n)，空间复杂度 O(1)
+func HasCycle[T any](L *LinkedList[T]) bool {
+	slow, fast := L.head, L.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakCycle 使用 Floyd 算法找到环的入口，将环上最后一个节点的 next 置为 nil，
+// 并据此修正 tail 与 length。链表成环并被断开时返回 true
+func BreakCycle[T any](L *LinkedList[T]) bool {
+	defer invalidateIndex(L)
+	slow, fast := L.head, L.head
+	for {
+		if fast == nil || fast.next == nil {
+			return false
+		}
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			break
+		}
+	}
+	// 从 head 与相遇点同步前进，再次相遇处即为环的入口
+	slow = L.head
+	for slow != fast {
+		slow = slow.next
+		fast = fast.next
+	}
+	last := slow
+	for last.next != slow {
+		last = last.next
+	}
+	last.next = nil
+	L.tail = last
+	L.length = 0
+	for current := L.head; current != nil; current = current.next {
+		L.length++
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+// makeCycle 将 tail.next 指向 position 处的节点，人为制造环
+func makeCycle[T any](t *testing.T, L *LinkedList[T], position int) {
+	t.Helper()
+	if position < 0 || position >= L.length {
+		t.Fatalf("makeCycle: 无效的位置 %d", position)
+	}
+	L.tail.next = nodeAt(L, position)
+}
+
+func TestHasCycle(t *testing.T) {
+	for position := 0; position < 5; position++ {
+		L := newListOf(1, 2, 3, 4, 5)
+		makeCycle(t, L, position)
+		if !HasCycle(L) {
+			t.Errorf("尾节点指向位置 %d 时应检测到环", position)
+		}
+	}
+}
+
+func TestHasCycleSelfLoop(t *testing.T) {
+	L := newListOf(1)
+	makeCycle(t, L, 0)
+	if !HasCycle(L) {
+		t.Fatal("自环应被检测到")
+	}
+}
+
+func TestHasCycleAcyclic(t *testing.T) {
+	for _, L := range []*LinkedList[int]{
+		NewLinkedList[int](),
+		newListOf(1),
+		newListOf(1, 2),
+		newListOf(1, 2, 3, 4, 5),
+	} {
+		if HasCycle(L) {
+			t.Errorf("HasCycle(%v) = true, want false", L)
+		}
+	}
+}
+
+func TestBreakCycle(t *testing.T) {
+	for position := 0; position < 5; position++ {
+		L := newListOf(1, 2, 3, 4, 5)
+		makeCycle(t, L, position)
+		if !BreakCycle(L) {
+			t.Fatalf("尾节点指向位置 %d 时 BreakCycle 应返回 true", position)
+		}
+		if HasCycle(L) {
+			t.Fatalf("BreakCycle 之后仍然成环 (position %d)", position)
+		}
+		if err := Validate(L); err != nil {
+			t.Fatalf("BreakCycle 之后 Validate 失败 (position %d): %v", position, err)
+		}
+		if got := collect(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+			t.Fatalf("BreakCycle 之后内容 = %v", got)
+		}
+	}
+}
+
+func TestBreakCycleCorruptedLength(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	makeCycle(t, L, 1)
+	L.tail, L.length = L.head, 1
+	if !BreakCycle(L) {
+		t.Fatal("BreakCycle 应返回 true")
+	}
+	if err := Validate(L); err != nil {
+		t.Fatalf("BreakCycle 应重新计算 tail 与 length: %v", err)
+	}
+	if Len(L) != 4 {
+		t.Fatalf("Len = %d, want 4", Len(L))
+	}
+}
+
+func TestBreakCycleAcyclic(t *testing.T) {
+	for _, L := range []*LinkedList[int]{NewLinkedList[int](), newListOf(1), newListOf(1, 2, 3)} {
+		before := collect(L)
+		if BreakCycle(L) {
+			t.Fatalf("BreakCycle(%v) = true, want false", L)
+		}
+		if !reflect.DeepEqual(collect(L), before) {
+			t.Fatal("无环链表不应被修改")
+		}
+		validateLength(t, L)
+	}
+}
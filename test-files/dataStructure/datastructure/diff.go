@@ -0,0 +1,54 @@
+package datastructure
+
+// Edit 的操作类型
+const (
+	EditInsert = "insert"
+	EditDelete = "delete"
+	EditEqual  = "equal"
+)
+
+// Edit 描述把一个链表变换为另一个链表的一步操作。
+// Index 为按顺序应用编辑时该操作在当前链表中的位置
+type Edit[T any] struct {
+	Op    string
+	Index int
+	Value T
+}
+
+// Diff 基于最长公共子序列计算把 a 变换为 b 的编辑脚本，时间与空间复杂度均为 O(len(a)*len(b))。
+// 依次应用返回的编辑：equal 保留 Index 处的值，delete 删除 Index 处的值，insert 在 Index 处插入 Value
+func Diff[T comparable](a, b *LinkedList[T]) []Edit[T] {
+	x, y := ToSlice(a), ToSlice(b)
+	// lcs[i][j] 为 x[i:] 与 y[j:] 的最长公共子序列长度
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit[T], 0, max(len(x), len(y)))
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			edits = append(edits, Edit[T]{Op: EditEqual, Index: j, Value: x[i]})
+			i++
+			j++
+		case j == len(y) || (i < len(x) && lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, Edit[T]{Op: EditDelete, Index: j, Value: x[i]})
+			i++
+		default:
+			edits = append(edits, Edit[T]{Op: EditInsert, Index: j, Value: y[j]})
+			j++
+		}
+	}
+	return edits
+}
@@ -0,0 +1,67 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+// applyEdits 在 a 的副本上依次应用 edits 并返回结果
+func applyEdits[T comparable](t *testing.T, a *LinkedList[T], edits []Edit[T]) *LinkedList[T] {
+	t.Helper()
+	result := Clone(a)
+	for _, e := range edits {
+		switch e.Op {
+		case EditEqual:
+			if v, err := Get(result, e.Index); err != nil || v != e.Value {
+				t.Fatalf("equal %+v 与当前值 (%v, %v) 不符", e, v, err)
+			}
+		case EditDelete:
+			if v, err := DeleteNodeReturn(result, e.Index); err != nil || v != e.Value {
+				t.Fatalf("delete %+v 删除了 (%v, %v)", e, v, err)
+			}
+		case EditInsert:
+			if err := InsertAt(result, e.Value, e.Index); err != nil {
+				t.Fatalf("insert %+v 失败: %v", e, err)
+			}
+		default:
+			t.Fatalf("未知的操作 %q", e.Op)
+		}
+	}
+	return result
+}
+
+func countOps[T any](edits []Edit[T]) map[string]int {
+	counts := map[string]int{}
+	for _, e := range edits {
+		counts[e.Op]++
+	}
+	return counts
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		ops  map[string]int
+	}{
+		{"相同", []int{1, 2, 3}, []int{1, 2, 3}, map[string]int{EditEqual: 3}},
+		{"纯插入", []int{1, 3}, []int{0, 1, 2, 3, 4}, map[string]int{EditEqual: 2, EditInsert: 3}},
+		{"纯删除", []int{0, 1, 2, 3, 4}, []int{1, 3}, map[string]int{EditEqual: 2, EditDelete: 3}},
+		{"混合", []int{1, 2, 3, 4, 5}, []int{1, 9, 3, 5, 6}, map[string]int{EditEqual: 3, EditDelete: 2, EditInsert: 2}},
+		{"都为空", nil, nil, map[string]int{}},
+		{"a 为空", nil, []int{1, 2}, map[string]int{EditInsert: 2}},
+		{"b 为空", []int{1, 2}, nil, map[string]int{EditDelete: 2}},
+	}
+	for _, tt := range tests {
+		a, b := newListOf(tt.a...), newListOf(tt.b...)
+		edits := Diff(a, b)
+		if got := countOps(edits); !reflect.DeepEqual(got, tt.ops) {
+			t.Fatalf("%s: 操作统计 = %v, want %v", tt.name, got, tt.ops)
+		}
+		result := applyEdits(t, a, edits)
+		if !Equals(result, b) {
+			t.Fatalf("%s: 应用编辑后 = %v, want %v", tt.name, result, b)
+		}
+		validateLength(t, result)
+	}
+}
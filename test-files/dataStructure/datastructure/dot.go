@@ -0,0 +1,43 @@
+package datastructure
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ToDOT 将链表导出为 Graphviz DOT 格式，每个元素一个节点，next 指针为有向边，
+// head 与 tail 使用不同颜色标出。遍历时记录已访问节点，遇到环会以红色虚线标出回边并停止
+func ToDOT[T any](L *LinkedList[T]) string {
+	var b strings.Builder
+	b.WriteString("digraph LinkedList {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	ids := make(map[*node[T]]int)
+	index := 0
+	for current := L.head; current != nil; current = current.next {
+		ids[current] = index
+		attrs := "label=" + strconv.Quote(fmt.Sprint(current.value))
+		switch {
+		case current == L.head && current == L.tail:
+			attrs += `, style=filled, fillcolor="lightblue:lightpink", xlabel="head/tail"`
+		case current == L.head:
+			attrs += `, style=filled, fillcolor=lightblue, xlabel="head"`
+		case current == L.tail:
+			attrs += `, style=filled, fillcolor=lightpink, xlabel="tail"`
+		}
+		fmt.Fprintf(&b, "\tn%d [%s];\n", index, attrs)
+
+		if next := current.next; next != nil {
+			if target, visited := ids[next]; visited {
+				fmt.Fprintf(&b, "\tn%d -> n%d [color=red, style=dashed, label=\"cycle\"];\n", index, target)
+				break
+			}
+			fmt.Fprintf(&b, "\tn%d -> n%d;\n", index, index+1)
+		}
+		index++
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package datastructure
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToDOT(t *testing.T) {
+	dot := ToDOT(newListOf(1, 2, 3))
+	for _, want := range []string{
+		"digraph LinkedList {",
+		`n0 [label="1", style=filled, fillcolor=lightblue, xlabel="head"];`,
+		`n1 [label="2"];`,
+		`n2 [label="3", style=filled, fillcolor=lightpink, xlabel="tail"];`,
+		"n0 -> n1;",
+		"n1 -> n2;",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT 输出缺少 %q:\n%s", want, dot)
+		}
+	}
+	if strings.Contains(dot, "n2 ->") {
+		t.Errorf("尾节点不应有出边:\n%s", dot)
+	}
+}
+
+func TestToDOTEmptyAndSingle(t *testing.T) {
+	if dot := ToDOT(NewLinkedList[int]()); strings.Contains(dot, "n0") {
+		t.Errorf("空链表不应有节点:\n%s", dot)
+	}
+	dot := ToDOT(newListOf(`say "hi"`))
+	if !strings.Contains(dot, `label="say \"hi\""`) || !strings.Contains(dot, `xlabel="head/tail"`) {
+		t.Errorf("单元素链表输出错误:\n%s", dot)
+	}
+}
+
+func TestToDOTCycle(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	makeCycle(t, L, 1)
+	dot := ToDOT(L)
+	if !strings.Contains(dot, `n3 -> n1 [color=red, style=dashed, label="cycle"];`) {
+		t.Errorf("应标出回边:\n%s", dot)
+	}
+	if strings.Count(dot, "[label=") != 4 {
+		t.Errorf("成环链表的每个节点应只输出一次:\n%s", dot)
+	}
+}
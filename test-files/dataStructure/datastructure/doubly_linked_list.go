@@ -0,0 +1,147 @@
+package datastructure
+
+type dnode[T any] struct {
+	value T
+	prev  *dnode[T]
+	next  *dnode[T]
+}
+
+// DoublyLinkedList 双向链表，头尾插入和删除均为 O(1)
+type DoublyLinkedList[T any] struct {
+	head   *dnode[T]
+	tail   *dnode[T]
+	length int
+}
+
+func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
+	return new(DoublyLinkedList[T])
+}
+
+// nodeAt 从距离更近的一端开始查找 position 处的节点，调用方需保证 position 合法
+func (D *DoublyLinkedList[T]) nodeAt(position int) *dnode[T] {
+	if position < D.length/2 {
+		current := D.head
+		for i := 0; i < position; i++ {
+			current = current.next
+		}
+		return current
+	}
+	current := D.tail
+	for i := D.length - 1; i > position; i-- {
+		current = current.prev
+	}
+	return current
+}
+
+// insertBefore 将 n 插入到 mark 之前，mark 为 nil 时插入到尾部
+func (D *DoublyLinkedList[T]) insertBefore(n, mark *dnode[T]) {
+	if mark == nil {
+		n.prev = D.tail
+		n.next = nil
+		if D.tail == nil {
+			D.head = n
+		} else {
+			D.tail.next = n
+		}
+		D.tail = n
+	} else {
+		n.prev = mark.prev
+		n.next = mark
+		if mark.prev == nil {
+			D.head = n
+		} else {
+			mark.prev.next = n
+		}
+		mark.prev = n
+	}
+	D.length++
+}
+
+// unlink 将 n 从链表中摘除
+func (D *DoublyLinkedList[T]) unlink(n *dnode[T]) {
+	if n.prev == nil {
+		D.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		D.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+	D.length--
+}
+
+// Append 在 position 处插入 value，position 取值范围为 [0, length]
+func (D *DoublyLinkedList[T]) Append(value T, position int) error {
+	if position < 0 || position > D.length {
+		return ErrInvalidPosition
+	}
+	var mark *dnode[T]
+	if position < D.length {
+		mark = D.nodeAt(position)
+	}
+	D.insertBefore(&dnode[T]{value: value}, mark)
+	return nil
+}
+
+// DeleteNode 删除 position 处的节点，position 取值范围为 [0, length)
+func (D *DoublyLinkedList[T]) DeleteNode(position int) error {
+	if position < 0 || position >= D.length {
+		return ErrInvalidPosition
+	}
+	D.unlink(D.nodeAt(position))
+	return nil
+}
+
+func (D *DoublyLinkedList[T]) Len() int {
+	return D.length
+}
+
+// Get 返回 position 处的值，position 取值范围为 [0, length)
+func (D *DoublyLinkedList[T]) Get(position int) (T, error) {
+	if position < 0 || position >= D.length {
+		var zero T
+		return zero, ErrInvalidPosition
+	}
+	return D.nodeAt(position).value, nil
+}
+
+func (D *DoublyLinkedList[T]) ToSlice() []T {
+	values := make([]T, 0, D.length)
+	for current := D.head; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return values
+}
+
+func (D *DoublyLinkedList[T]) PushFront(value T) {
+	D.insertBefore(&dnode[T]{value: value}, D.head)
+}
+
+func (D *DoublyLinkedList[T]) PushBack(value T) {
+	D.insertBefore(&dnode[T]{value: value}, nil)
+}
+
+func (D *DoublyLinkedList[T]) PopFront() (T, error) {
+	if D.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	n := D.head
+	D.unlink(n)
+	return n.value, nil
+}
+
+// PopBack 删除并返回尾节点的值，借助 prev 指针为 O(1)
+func (D *DoublyLinkedList[T]) PopBack() (T, error) {
+	if D.tail == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	n := D.tail
+	D.unlink(n)
+	return n.value, nil
+}
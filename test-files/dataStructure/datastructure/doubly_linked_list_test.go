@@ -0,0 +1,142 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// validateDoubly 检查双向链表的 prev/next 指针、tail 与 length 一致
+func validateDoubly[T any](t *testing.T, D *DoublyLinkedList[T]) {
+	t.Helper()
+	count := 0
+	var prev *dnode[T]
+	for current := D.head; current != nil; current = current.next {
+		if current.prev != prev {
+			t.Fatalf("第 %d 个节点的 prev 指针错误", count)
+		}
+		prev = current
+		count++
+	}
+	if prev != D.tail {
+		t.Fatal("最后一个节点与 tail 不一致")
+	}
+	if count != D.length {
+		t.Fatalf("节点数 %d 与 length %d 不一致", count, D.length)
+	}
+}
+
+func TestDoublyLinkedListAppendDelete(t *testing.T) {
+	D := NewDoublyLinkedList[int]()
+	for i, v := range []int{1, 2, 4} {
+		if err := D.Append(v, i); err != nil {
+			t.Fatalf("Append 失败: %v", err)
+		}
+	}
+	if err := D.Append(3, 2); err != nil {
+		t.Fatalf("Append 失败: %v", err)
+	}
+	if err := D.Append(0, 0); err != nil {
+		t.Fatalf("Append 失败: %v", err)
+	}
+	if got, want := D.ToSlice(), []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateDoubly(t, D)
+
+	for _, position := range []int{4, 0, 1} {
+		if err := D.DeleteNode(position); err != nil {
+			t.Fatalf("DeleteNode(%d) 失败: %v", position, err)
+		}
+		validateDoubly(t, D)
+	}
+	if got, want := D.ToSlice(), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	if err := D.Append(9, 5); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("越界插入应返回错误")
+	}
+	if err := D.DeleteNode(2); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("越界删除应返回错误")
+	}
+}
+
+func TestDoublyLinkedListPushPop(t *testing.T) {
+	D := NewDoublyLinkedList[string]()
+	if _, err := D.PopBack(); !errors.Is(err, ErrEmptyList) {
+		t.Fatal("空链表 PopBack 应返回错误")
+	}
+	if _, err := D.PopFront(); !errors.Is(err, ErrEmptyList) {
+		t.Fatal("空链表 PopFront 应返回错误")
+	}
+	D.PushBack("b")
+	D.PushFront("a")
+	D.PushBack("c")
+	if v, err := D.PopBack(); err != nil || v != "c" {
+		t.Fatalf("PopBack = (%q, %v), want (c, nil)", v, err)
+	}
+	if v, err := D.PopFront(); err != nil || v != "a" {
+		t.Fatalf("PopFront = (%q, %v), want (a, nil)", v, err)
+	}
+	validateDoubly(t, D)
+	if v, err := D.PopBack(); err != nil || v != "b" {
+		t.Fatalf("PopBack = (%q, %v), want (b, nil)", v, err)
+	}
+	if D.head != nil || D.tail != nil || D.Len() != 0 {
+		t.Fatalf("弹出所有元素后链表应为空: %+v", D)
+	}
+}
+
+func TestDoublyLinkedListGet(t *testing.T) {
+	D := NewDoublyLinkedList[int]()
+	for i := 0; i < 7; i++ {
+		D.PushBack(i * 10)
+	}
+	for i := 0; i < 7; i++ {
+		if v, err := D.Get(i); err != nil || v != i*10 {
+			t.Errorf("Get(%d) = (%d, %v), want (%d, nil)", i, v, err, i*10)
+		}
+	}
+	if _, err := D.Get(7); !errors.Is(err, ErrInvalidPosition) {
+		t.Error("越界 Get 应返回错误")
+	}
+}
+
+// runListScenario 通过 List 接口执行同一组操作并返回每一步后的快照
+func runListScenario(l List[int]) [][]int {
+	var snapshots [][]int
+	record := func() { snapshots = append(snapshots, l.ToSlice()) }
+	for i := 0; i < 5; i++ {
+		_ = l.Append(i, l.Len())
+	}
+	record()
+	_ = l.Append(100, 0)
+	_ = l.Append(200, 3)
+	record()
+	_ = l.DeleteNode(0)
+	_ = l.DeleteNode(l.Len() - 1)
+	record()
+	if err := l.DeleteNode(l.Len()); err == nil {
+		snapshots = append(snapshots, []int{-1})
+	}
+	v, _ := l.Get(2)
+	snapshots = append(snapshots, []int{v, l.Len()})
+	return snapshots
+}
+
+func TestListInterfaceImplementations(t *testing.T) {
+	singly := runListScenario(NewLinkedList[int]())
+	doubly := runListScenario(NewDoublyLinkedList[int]())
+	if !reflect.DeepEqual(singly, doubly) {
+		t.Fatalf("单向链表与双向链表结果不一致:\n%v\n%v", singly, doubly)
+	}
+	want := [][]int{
+		{0, 1, 2, 3, 4},
+		{100, 0, 1, 200, 2, 3, 4},
+		{0, 1, 200, 2, 3},
+		{200, 5},
+	}
+	if !reflect.DeepEqual(singly, want) {
+		t.Fatalf("scenario = %v, want %v", singly, want)
+	}
+}
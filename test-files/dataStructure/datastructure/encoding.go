@@ -0,0 +1,114 @@
+package datastructure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// MarshalJSON 将链表编码为 JSON 数组，例如 [1,2,3]
+func (L *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ToSlice(L))
+}
+
+// UnmarshalJSON 从 JSON 数组重建链表，原有节点会被丢弃。
+// 有界链表按顺序追加的规则只保留最后 capacity 个值，多出的值计入 Evictions
+func (L *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	defer invalidateIndex(L)
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	rebuilt := FromSlice(values)
+	L.head = rebuilt.head
+	L.tail = rebuilt.tail
+	L.length = rebuilt.length
+	enforceCapacity(L)
+	return nil
+}
+
+var (
+	_ gob.GobEncoder = (*LinkedList[int])(nil)
+	_ gob.GobDecoder = (*LinkedList[int])(nil)
+)
+
+// GobEncode 按顺序将链表的值编码为 gob 二进制数据
+func (L *LinkedList[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(ToSlice(L)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode 从 gob 二进制数据重建链表，原有节点会被丢弃，容量规则与 UnmarshalJSON 相同
+func (L *LinkedList[T]) GobDecode(data []byte) error {
+	defer invalidateIndex(L)
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	rebuilt := FromSlice(values)
+	L.head = rebuilt.head
+	L.tail = rebuilt.tail
+	L.length = rebuilt.length
+	enforceCapacity(L)
+	return nil
+}
+
+// Integer 可按定长二进制流式编码的整数类型
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// WriteTo 先写入 8 字节大端序的长度前缀，再将每个值转换为 int64 按 8 字节大端序写入 w，
+// 不会在内存中构造完整的编码结果。返回值遵循 io.WriterTo 的约定，为实际写入的字节数
+func WriteTo[T Integer](L *LinkedList[T], w io.Writer) (int64, error) {
+	var buf [8]byte
+	var written int64
+	binary.BigEndian.PutUint64(buf[:], uint64(L.length))
+	n, err := w.Write(buf[:])
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+	for current := L.head; current != nil; current = current.next {
+		binary.BigEndian.PutUint64(buf[:], uint64(int64(current.value)))
+		n, err = w.Write(buf[:])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ReadFrom 从 r 读取 WriteTo 写出的数据并重建链表，返回值中的字节数遵循 io.ReaderFrom 的约定。
+// 数据在长度前缀声明的元素读完之前结束时返回 io.ErrUnexpectedEOF
+func ReadFrom[T Integer](r io.Reader) (*LinkedList[T], int64, error) {
+	var buf [8]byte
+	var read int64
+	n, err := io.ReadFull(r, buf[:])
+	read += int64(n)
+	if err != nil {
+		return nil, read, err
+	}
+	length := binary.BigEndian.Uint64(buf[:])
+	L := NewLinkedList[T]()
+	for i := uint64(0); i < length; i++ {
+		n, err = io.ReadFull(r, buf[:])
+		read += int64(n)
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, read, err
+		}
+		PushBack(L, T(int64(binary.BigEndian.Uint64(buf[:]))))
+	}
+	return L, read, nil
+}
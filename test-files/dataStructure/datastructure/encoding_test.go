@@ -0,0 +1,218 @@
+package datastructure
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	data, err := json.Marshal(L)
+	if err != nil {
+		t.Fatalf("Marshal 失败: %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf("Marshal = %s, want [1,2,3]", data)
+	}
+	decoded := NewLinkedList[int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if got := ToSlice(decoded); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("ToSlice = %v", got)
+	}
+	validateLength(t, decoded)
+}
+
+func TestJSONEmpty(t *testing.T) {
+	data, err := json.Marshal(NewLinkedList[string]())
+	if err != nil {
+		t.Fatalf("Marshal 失败: %v", err)
+	}
+	if string(data) != "[]" {
+		t.Fatalf("Marshal(empty) = %s, want []", data)
+	}
+	decoded := newListOf("stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if !ListIsEmpty(decoded) || decoded.tail != nil || Len(decoded) != 0 {
+		t.Fatalf("Unmarshal([]) 应重置链表: %+v", decoded)
+	}
+}
+
+func TestJSONStructField(t *testing.T) {
+	type payload struct {
+		Points *LinkedList[point] `json:"points"`
+	}
+	in := payload{Points: newListOf(point{1, 2}, point{3, 4})}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal 失败: %v", err)
+	}
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if got, want := ToSlice(out.Points), ToSlice(in.Points); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, out.Points)
+}
+
+func TestJSONInvalid(t *testing.T) {
+	L := newListOf(1)
+	if err := json.Unmarshal([]byte(`{"a":1}`), L); err == nil {
+		t.Fatal("非数组输入应返回错误")
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("解码失败不应修改链表: %v", got)
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	tests := []*LinkedList[int]{
+		newListOf(1, 2, 3),
+		newListOf(42),
+		NewLinkedList[int](),
+	}
+	for _, L := range tests {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(L); err != nil {
+			t.Fatalf("Encode 失败: %v", err)
+		}
+		decoded := newListOf(-1, -2)
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("Decode 失败: %v", err)
+		}
+		if !Equals(decoded, L) {
+			t.Fatalf("往返后 = %v, want %v", decoded, L)
+		}
+		validateLength(t, decoded)
+	}
+}
+
+func TestGobStructField(t *testing.T) {
+	type payload struct {
+		Name  string
+		Items *LinkedList[string]
+	}
+	in := payload{Name: "p", Items: newListOf("a", "b")}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode 失败: %v", err)
+	}
+	var out payload
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode 失败: %v", err)
+	}
+	if out.Name != "p" || !Equals(out.Items, in.Items) {
+		t.Fatalf("out = %+v", out)
+	}
+	validateLength(t, out.Items)
+}
+
+func TestWriteToReadFromRoundTrip(t *testing.T) {
+	tests := []*LinkedList[int]{
+		newListOf(1, -2, 3, 1<<40),
+		newListOf(0),
+		NewLinkedList[int](),
+	}
+	for _, L := range tests {
+		var buf bytes.Buffer
+		written, err := WriteTo(L, &buf)
+		if err != nil {
+			t.Fatalf("WriteTo 失败: %v", err)
+		}
+		if want := int64(8 * (Len(L) + 1)); written != want || int64(buf.Len()) != want {
+			t.Fatalf("WriteTo 字节数 = %d (buf %d), want %d", written, buf.Len(), want)
+		}
+		decoded, read, err := ReadFrom[int](&buf)
+		if err != nil {
+			t.Fatalf("ReadFrom 失败: %v", err)
+		}
+		if read != written {
+			t.Fatalf("ReadFrom 字节数 = %d, want %d", read, written)
+		}
+		if !Equals(decoded, L) {
+			t.Fatalf("往返后 = %v, want %v", decoded, L)
+		}
+		validateLength(t, decoded)
+	}
+}
+
+func TestWriteToReadFromUnsigned(t *testing.T) {
+	L := newListOf[uint64](0, 1, 1<<63+5)
+	var buf bytes.Buffer
+	if _, err := WriteTo(L, &buf); err != nil {
+		t.Fatalf("WriteTo 失败: %v", err)
+	}
+	decoded, _, err := ReadFrom[uint64](&buf)
+	if err != nil {
+		t.Fatalf("ReadFrom 失败: %v", err)
+	}
+	if !Equals(decoded, L) {
+		t.Fatalf("往返后 = %v, want %v", decoded, L)
+	}
+}
+
+func TestReadFromTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := WriteTo(newListOf(1, 2, 3), &buf); err != nil {
+		t.Fatalf("WriteTo 失败: %v", err)
+	}
+	data := buf.Bytes()
+	for _, size := range []int{0, 4, 8, 12, 16, len(data) - 1} {
+		_, read, err := ReadFrom[int](bytes.NewReader(data[:size]))
+		if err == nil {
+			t.Fatalf("截断到 %d 字节时应返回错误", size)
+		}
+		if size > 0 && !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Fatalf("截断到 %d 字节时错误 = %v, want io.ErrUnexpectedEOF", size, err)
+		}
+		if read != int64(size) {
+			t.Fatalf("截断到 %d 字节时读取字节数 = %d", size, read)
+		}
+	}
+}
+
+func TestJSONBoundedList(t *testing.T) {
+	L := NewBoundedList[int](2)
+	if err := json.Unmarshal([]byte("[1,2,3,4]"), L); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Fatalf("ToSlice = %v, want [3 4]", got)
+	}
+	if Len(L) != 2 || Evictions(L) != 2 {
+		t.Fatalf("Len = %d, Evictions = %d, want 2, 2", Len(L), Evictions(L))
+	}
+	if err := Validate(L); err != nil {
+		t.Fatalf("Validate 失败: %v", err)
+	}
+}
+
+func TestGobBoundedList(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(newListOf(1, 2, 3, 4)); err != nil {
+		t.Fatalf("Encode 失败: %v", err)
+	}
+	L := NewBoundedList[int](3)
+	if err := gob.NewDecoder(&buf).Decode(L); err != nil {
+		t.Fatalf("Decode 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Fatalf("ToSlice = %v, want [2 3 4]", got)
+	}
+	if Len(L) != 3 || Evictions(L) != 1 {
+		t.Fatalf("Len = %d, Evictions = %d, want 3, 1", Len(L), Evictions(L))
+	}
+	if err := Validate(L); err != nil {
+		t.Fatalf("Validate 失败: %v", err)
+	}
+}
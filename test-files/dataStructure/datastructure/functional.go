@@ -0,0 +1,118 @@
+package datastructure
+
+import "context"
+
+// Map 返回一个新链表，其中每个值为 f 作用于原值的结果，不修改原链表
+func Map[T, U any](L *LinkedList[T], f func(T) U) *LinkedList[U] {
+	result := NewLinkedList[U]()
+	for current := L.head; current != nil; current = current.next {
+		PushBack(result, f(current.value))
+	}
+	return result
+}
+
+// Filter 返回仅包含满足 pred 的值的新链表，不修改原链表
+func Filter[T any](L *LinkedList[T], pred func(T) bool) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			PushBack(result, current.value)
+		}
+	}
+	return result
+}
+
+// SplitByPredicate 返回满足 pred 与不满足 pred 的值组成的两个新链表，保持原有顺序，不修改原链表
+func SplitByPredicate[T any](L *LinkedList[T], pred func(T) bool) (matched, rest *LinkedList[T]) {
+	matched, rest = NewLinkedList[T](), NewLinkedList[T]()
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			PushBack(matched, current.value)
+		} else {
+			PushBack(rest, current.value)
+		}
+	}
+	return matched, rest
+}
+
+// Reduce 从 init 开始依次用 f 累积链表中的值
+func Reduce[T, A any](L *LinkedList[T], init A, f func(acc A, v T) A) A {
+	acc := init
+	for current := L.head; current != nil; current = current.next {
+		acc = f(acc, current.value)
+	}
+	return acc
+}
+
+// Scan 与 Reduce 相同，但返回每一步累积结果组成的新链表，长度与原链表相同，不修改原链表
+func Scan[T, A any](L *LinkedList[T], init A, f func(acc A, v T) A) *LinkedList[A] {
+	result := NewLinkedList[A]()
+	acc := init
+	for current := L.head; current != nil; current = current.next {
+		acc = f(acc, current.value)
+		PushBack(result, acc)
+	}
+	return result
+}
+
+// ForEach 按顺序对每个元素调用 f，f 返回 false 时停止遍历
+func ForEach[T any](L *LinkedList[T], f func(index int, value T) bool) {
+	index := 0
+	for current := L.head; current != nil; current = current.next {
+		if !f(index, current.value) {
+			return
+		}
+		index++
+	}
+}
+
+// TakeWhile 返回开头连续满足 pred 的元素组成的新链表，遇到第一个不满足的元素即停止
+func TakeWhile[T any](L *LinkedList[T], pred func(T) bool) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for current := L.head; current != nil && pred(current.value); current = current.next {
+		PushBack(result, current.value)
+	}
+	return result
+}
+
+// DropWhile 跳过开头连续满足 pred 的元素，返回其余元素组成的新链表
+func DropWhile[T any](L *LinkedList[T], pred func(T) bool) *LinkedList[T] {
+	current := L.head
+	for current != nil && pred(current.value) {
+		current = current.next
+	}
+	result := NewLinkedList[T]()
+	for ; current != nil; current = current.next {
+		PushBack(result, current.value)
+	}
+	return result
+}
+
+// GroupBy 遍历一次，按 key 的结果将值分组到各自的新链表中，组内保持原有顺序
+func GroupBy[T any, K comparable](L *LinkedList[T], key func(T) K) map[K]*LinkedList[T] {
+	groups := make(map[K]*LinkedList[T])
+	for current := L.head; current != nil; current = current.next {
+		k := key(current.value)
+		group, ok := groups[k]
+		if !ok {
+			group = NewLinkedList[T]()
+			groups[k] = group
+		}
+		PushBack(group, current.value)
+	}
+	return groups
+}
+
+// ForEachContext 依次对每个值调用 f，每访问一个节点前检查 ctx，
+// ctx 已取消时返回 ctx.Err()，f 返回非 nil 错误时立即返回该错误
+func ForEachContext[T any](ctx context.Context, L *LinkedList[T], f func(T) error) error {
+	for current := L.head; current != nil; current = current.next {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := f(current.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
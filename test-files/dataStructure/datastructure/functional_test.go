@@ -0,0 +1,266 @@
+package datastructure
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	doubled := Map(L, func(v int) int { return v * 2 })
+	if got, want := ToSlice(doubled), []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Map = %v, want %v", got, want)
+	}
+	validateLength(t, doubled)
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("Map 不应修改原链表: %v", got)
+	}
+
+	strs := Map(L, strconv.Itoa)
+	if got, want := ToSlice(strs), []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Map = %v, want %v", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5, 6)
+	evens := Filter(L, func(v int) bool { return v%2 == 0 })
+	if got, want := ToSlice(evens), []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Filter = %v, want %v", got, want)
+	}
+	validateLength(t, evens)
+	if Len(L) != 6 {
+		t.Fatalf("Filter 不应修改原链表, Len = %d", Len(L))
+	}
+}
+
+func TestSplitByPredicate(t *testing.T) {
+	tests := []struct {
+		name          string
+		pred          func(int) bool
+		matched, rest []int
+	}{
+		{"全部满足", func(int) bool { return true }, []int{1, 2, 3, 4}, []int{}},
+		{"全部不满足", func(int) bool { return false }, []int{}, []int{1, 2, 3, 4}},
+		{"混合", func(v int) bool { return v%2 == 0 }, []int{2, 4}, []int{1, 3}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4)
+		matched, rest := SplitByPredicate(L, tt.pred)
+		if got := collect(matched); !reflect.DeepEqual(got, tt.matched) {
+			t.Fatalf("%s: matched = %v, want %v", tt.name, got, tt.matched)
+		}
+		if got := collect(rest); !reflect.DeepEqual(got, tt.rest) {
+			t.Fatalf("%s: rest = %v, want %v", tt.name, got, tt.rest)
+		}
+		validateLength(t, matched)
+		validateLength(t, rest)
+		if Len(matched)+Len(rest) != Len(L) {
+			t.Fatalf("%s: 两个结果应恰好划分原链表", tt.name)
+		}
+		if got := collect(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+			t.Fatalf("%s: SplitByPredicate 不应修改原链表: %v", tt.name, got)
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	sum := Reduce(L, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Fatalf("Reduce = %d, want 10", sum)
+	}
+}
+
+func TestScan(t *testing.T) {
+	L := newListOf(1, 2, 3, -1, 5)
+	sums := Scan(L, 0, func(acc, v int) int { return acc + v })
+	if got, want := collect(sums), []int{1, 3, 6, 5, 10}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("累加 Scan = %v, want %v", got, want)
+	}
+	maxes := Scan(L, math.MinInt, func(acc, v int) int { return max(acc, v) })
+	if got, want := collect(maxes), []int{1, 2, 3, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("最大值 Scan = %v, want %v", got, want)
+	}
+	labels := Scan(L, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if got := collect(labels); got[len(got)-1] != "123-15" {
+		t.Fatalf("字符串 Scan = %v", got)
+	}
+	validateLength(t, sums)
+	if got := collect(L); !reflect.DeepEqual(got, []int{1, 2, 3, -1, 5}) {
+		t.Fatalf("Scan 不应修改原链表: %v", got)
+	}
+
+	empty := Scan(NewLinkedList[int](), 0, func(acc, v int) int { return acc + v })
+	if Len(empty) != 0 {
+		t.Fatalf("空链表 Scan 的长度 = %d, want 0", Len(empty))
+	}
+	validateLength(t, empty)
+}
+
+func TestFunctionalEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	mapped := Map(L, func(v int) int { return v * 2 })
+	filtered := Filter(L, func(int) bool { return true })
+	for _, result := range []*LinkedList[int]{mapped, filtered} {
+		if !ListIsEmpty(result) || result.tail != nil || Len(result) != 0 {
+			t.Fatalf("空链表的结果应为空: %+v", result)
+		}
+	}
+	if got := Reduce(L, 42, func(acc, v int) int { return acc + v }); got != 42 {
+		t.Fatalf("Reduce(empty) = %d, want 42", got)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	L := newListOf("a", "b", "c")
+	var indices []int
+	var values []string
+	ForEach(L, func(i int, v string) bool {
+		indices = append(indices, i)
+		values = append(values, v)
+		return true
+	})
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) || !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Fatalf("indices = %v, values = %v", indices, values)
+	}
+}
+
+func TestForEachStop(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	calls := 0
+	ForEach(L, func(_ int, v int) bool {
+		calls++
+		return v < 2
+	})
+	if calls != 2 {
+		t.Fatalf("返回 false 后应停止遍历, calls = %d", calls)
+	}
+}
+
+func TestForEachEmpty(t *testing.T) {
+	ForEach(NewLinkedList[int](), func(int, int) bool {
+		t.Fatal("空链表不应调用 f")
+		return true
+	})
+}
+
+func TestTakeDropWhile(t *testing.T) {
+	tests := []struct {
+		name       string
+		pred       func(int) bool
+		take, drop []int
+	}{
+		{"all", func(int) bool { return true }, []int{1, 2, 3, 1}, []int{}},
+		{"none", func(int) bool { return false }, []int{}, []int{1, 2, 3, 1}},
+		{"prefix", func(v int) bool { return v < 3 }, []int{1, 2}, []int{3, 1}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 1)
+		take, drop := TakeWhile(L, tt.pred), DropWhile(L, tt.pred)
+		if got := ToSlice(take); !reflect.DeepEqual(got, tt.take) {
+			t.Errorf("%s: TakeWhile = %v, want %v", tt.name, got, tt.take)
+		}
+		if got := ToSlice(drop); !reflect.DeepEqual(got, tt.drop) {
+			t.Errorf("%s: DropWhile = %v, want %v", tt.name, got, tt.drop)
+		}
+		validateLength(t, take)
+		validateLength(t, drop)
+		if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3, 1}) {
+			t.Errorf("%s: 不应修改原链表: %v", tt.name, got)
+		}
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5, 6, 7)
+	tests := []struct {
+		name string
+		key  func(int) int
+		want map[int][]int
+	}{
+		{"奇偶", func(v int) int { return v % 2 }, map[int][]int{0: {2, 4, 6}, 1: {1, 3, 5, 7}}},
+		{"模 3", func(v int) int { return v % 3 }, map[int][]int{0: {3, 6}, 1: {1, 4, 7}, 2: {2, 5}}},
+	}
+	for _, tt := range tests {
+		groups := GroupBy(L, tt.key)
+		if len(groups) != len(tt.want) {
+			t.Fatalf("%s: 分组数 = %d, want %d", tt.name, len(groups), len(tt.want))
+		}
+		for k, want := range tt.want {
+			group, ok := groups[k]
+			if !ok {
+				t.Fatalf("%s: 缺少分组 %d", tt.name, k)
+			}
+			if got := collect(group); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s: 分组 %d = %v, want %v", tt.name, k, got, want)
+			}
+			validateLength(t, group)
+		}
+	}
+	if groups := GroupBy(NewLinkedList[int](), func(v int) int { return v }); len(groups) != 0 {
+		t.Fatalf("空链表的分组应为空, got %v", groups)
+	}
+}
+
+func TestForEachContext(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	var seen []int
+	err := ForEachContext(context.Background(), L, func(v int) error {
+		seen = append(seen, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEachContext 返回错误: %v", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3, 4}) {
+		t.Fatalf("访问顺序 = %v", seen)
+	}
+}
+
+func TestForEachContextCancelled(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	var seen []int
+	err := ForEachContext(ctx, L, func(v int) error {
+		seen = append(seen, v)
+		if v == 2 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("错误 = %v, want context.Canceled", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2}) {
+		t.Fatalf("取消后应停止遍历, seen = %v", seen)
+	}
+
+	calls := 0
+	if err := ForEachContext(ctx, L, func(int) error { calls++; return nil }); !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Fatalf("已取消的 ctx 不应调用 f: err = %v, calls = %d", err, calls)
+	}
+}
+
+func TestForEachContextError(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	errStop := errors.New("stop")
+	calls := 0
+	err := ForEachContext(context.Background(), L, func(v int) error {
+		calls++
+		if v == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Fatalf("错误 = %v, want errStop", err)
+	}
+	if calls != 3 {
+		t.Fatalf("f 返回错误后应立即停止, calls = %d", calls)
+	}
+}
@@ -0,0 +1,67 @@
+package datastructure
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// FuzzListOps 将输入按每 3 个字节解释为一次操作 (op, position, value)，
+// 同时作用于链表和参照切片，每一步之后比较两者并检查 Validate
+func FuzzListOps(f *testing.F) {
+	// 在位置 0 插入、删除头节点、在位置 length 插入
+	f.Add([]byte{0, 1, 5, 1, 1, 0, 0, 1, 7, 0, 2, 8})
+	f.Add([]byte{0, 1, 1, 0, 2, 2, 0, 3, 3, 1, 3, 0, 1, 2, 0, 0, 1, 9})
+	f.Add([]byte{1, 0, 0, 2, 0, 0, 0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		L := NewLinkedList[int]()
+		var ref []int
+		for i := 0; i+2 < len(data); i += 3 {
+			op, value := data[i]%3, int(data[i+2])
+			// 取值范围为 [-1, len(ref)]，两端各覆盖一个越界位置
+			position := int(data[i+1])%(len(ref)+2) - 1
+			switch op {
+			case 0:
+				err := Append(L, value, position)
+				if position < 0 || position > len(ref) {
+					if !errors.Is(err, ErrInvalidPosition) {
+						t.Fatalf("Append(%d) err = %v, want ErrInvalidPosition", position, err)
+					}
+				} else {
+					if err != nil {
+						t.Fatalf("Append(%d) err = %v", position, err)
+					}
+					ref = slices.Insert(ref, position, value)
+				}
+			case 1:
+				err := DeleteNode(L, position)
+				if position < 0 || position >= len(ref) {
+					if !errors.Is(err, ErrInvalidPosition) {
+						t.Fatalf("DeleteNode(%d) err = %v, want ErrInvalidPosition", position, err)
+					}
+				} else {
+					if err != nil {
+						t.Fatalf("DeleteNode(%d) err = %v", position, err)
+					}
+					ref = slices.Delete(ref, position, position+1)
+				}
+			case 2:
+				got, err := Get(L, position)
+				if position < 0 || position >= len(ref) {
+					if !errors.Is(err, ErrInvalidPosition) {
+						t.Fatalf("Get(%d) err = %v, want ErrInvalidPosition", position, err)
+					}
+				} else if err != nil || got != ref[position] {
+					t.Fatalf("Get(%d) = (%d, %v), want (%d, nil)", position, got, err, ref[position])
+				}
+			}
+			if err := Validate(L); err != nil {
+				t.Fatalf("第 %d 步后 Validate 失败: %v", i/3, err)
+			}
+			if got := ToSlice(L); !slices.Equal(got, ref) {
+				t.Fatalf("第 %d 步后 ToSlice = %v, want %v", i/3, got, ref)
+			}
+		}
+	})
+}
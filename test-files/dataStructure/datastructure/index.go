@@ -0,0 +1,17 @@
+package datastructure
+
+// NewIndexedList 创建带位置缓存的链表。
+// 缓存记录最近一次按位置访问的节点及其下标，之后访问不小于该下标的位置时从缓存节点继续向后查找，
+// 顺序访问 Get(i) 可由 O(n) 降为均摊 O(1)。任何结构变更都会使缓存失效。
+// 由于读取也会更新缓存，带缓存的链表不能在无锁保护的情况下并发读取
+func NewIndexedList[T any]() *LinkedList[T] {
+	L := NewLinkedList[T]()
+	L.indexed = true
+	return L
+}
+
+// invalidateIndex 在结构变更后清除位置缓存
+func invalidateIndex[T any](L *LinkedList[T]) {
+	L.cacheNode = nil
+	L.cachePos = 0
+}
@@ -0,0 +1,99 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+// assertGetAll 逐个下标读取并与 want 比较
+func assertGetAll(t *testing.T, L *LinkedList[int], want []int) {
+	t.Helper()
+	for i, w := range want {
+		got, err := Get(L, i)
+		if err != nil || got != w {
+			t.Fatalf("Get(%d) = (%d, %v), want (%d, nil)", i, got, err, w)
+		}
+	}
+	if !reflect.DeepEqual(ToSlice(L), want) {
+		t.Fatalf("ToSlice = %v, want %v", ToSlice(L), want)
+	}
+}
+
+func TestIndexedListSequentialGet(t *testing.T) {
+	L := NewIndexedList[int]()
+	AppendSlice(L, []int{0, 10, 20, 30, 40})
+	assertGetAll(t, L, []int{0, 10, 20, 30, 40})
+	if L.cacheNode == nil || L.cachePos != 4 {
+		t.Fatalf("顺序访问后缓存应指向位置 4, cachePos = %d", L.cachePos)
+	}
+	if v, _ := Get(L, 1); v != 10 {
+		t.Fatalf("向前访问 Get(1) = %d, want 10", v)
+	}
+}
+
+func TestIndexedListInvalidation(t *testing.T) {
+	L := NewIndexedList[int]()
+	AppendSlice(L, []int{1, 2, 3, 4, 5})
+	steps := []struct {
+		name   string
+		mutate func()
+		want   []int
+	}{
+		{"Append head", func() { _ = Append(L, 0, 0) }, []int{0, 1, 2, 3, 4, 5}},
+		{"Append middle", func() { _ = Append(L, 9, 3) }, []int{0, 1, 2, 9, 3, 4, 5}},
+		{"DeleteNode", func() { _ = DeleteNode(L, 1) }, []int{0, 2, 9, 3, 4, 5}},
+		{"PushFront", func() { PushFront(L, 7) }, []int{7, 0, 2, 9, 3, 4, 5}},
+		{"PopFront", func() { _, _ = PopFront(L) }, []int{0, 2, 9, 3, 4, 5}},
+		{"Reverse", func() { Reverse(L) }, []int{5, 4, 3, 9, 2, 0}},
+		{"Rotate", func() { Rotate(L, 2) }, []int{3, 9, 2, 0, 5, 4}},
+		{"Swap", func() { _ = Swap(L, 0, 5) }, []int{4, 9, 2, 0, 5, 3}},
+		{"Sort", func() { Sort(L) }, []int{0, 2, 3, 4, 5, 9}},
+		{"RemoveRange", func() { _ = RemoveRange(L, 1, 3) }, []int{0, 4, 5, 9}},
+		{"RemoveValue", func() { RemoveValue(L, 0) }, []int{4, 5, 9}},
+		{"Clear", func() { Clear(L) }, []int{}},
+		{"AppendSlice", func() { AppendSlice(L, []int{8, 6}) }, []int{8, 6}},
+	}
+	for _, step := range steps {
+		assertGetAll(t, L, ToSlice(L))
+		step.mutate()
+		if L.cacheNode != nil {
+			t.Fatalf("%s 之后缓存应失效", step.name)
+		}
+		assertGetAll(t, L, step.want)
+		validateLength(t, L)
+	}
+}
+
+func TestIndexedListSet(t *testing.T) {
+	L := NewIndexedList[int]()
+	AppendSlice(L, []int{1, 2, 3})
+	for i := 0; i < 3; i++ {
+		if err := Set(L, i, i*100); err != nil {
+			t.Fatalf("Set(%d) 失败: %v", i, err)
+		}
+	}
+	assertGetAll(t, L, []int{0, 100, 200})
+}
+
+func benchmarkSequentialGet(b *testing.B, L *LinkedList[int]) {
+	const size = 1000
+	for i := 0; i < size; i++ {
+		PushBack(L, i)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < size; i++ {
+			if _, err := Get(L, i); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkSequentialGet(b *testing.B) {
+	benchmarkSequentialGet(b, NewLinkedList[int]())
+}
+
+func BenchmarkSequentialGetIndexed(b *testing.B) {
+	benchmarkSequentialGet(b, NewIndexedList[int]())
+}
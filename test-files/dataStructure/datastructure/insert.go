@@ -0,0 +1,102 @@
+package datastructure
+
+// InsertAfter 在第一个等于 target 的节点之后插入 value，返回是否找到 target
+func InsertAfter[T comparable](L *LinkedList[T], target, value T) bool {
+	defer invalidateIndex(L)
+	for current := L.head; current != nil; current = current.next {
+		if current.value == target {
+			newNode := &node[T]{value: value, next: current.next}
+			current.next = newNode
+			if current == L.tail {
+				L.tail = newNode
+			}
+			L.length++
+			enforceCapacity(L)
+			return true
+		}
+	}
+	return false
+}
+
+// InsertBefore 在第一个等于 target 的节点之前插入 value，返回是否找到 target
+func InsertBefore[T comparable](L *LinkedList[T], target, value T) bool {
+	defer invalidateIndex(L)
+	var prev *node[T]
+	for current := L.head; current != nil; current = current.next {
+		if current.value == target {
+			newNode := &node[T]{value: value, next: current}
+			if prev == nil {
+				L.head = newNode
+			} else {
+				prev.next = newNode
+			}
+			L.length++
+			if prev == nil {
+				enforceCapacityFront(L)
+			} else {
+				enforceCapacity(L)
+			}
+			return true
+		}
+		prev = current
+	}
+	return false
+}
+
+// AppendSlice 将 values 依次链接到尾部，只更新一次 tail 和 length，values 为空时不做任何事
+func AppendSlice[T any](L *LinkedList[T], values []T) {
+	defer invalidateIndex(L)
+	if len(values) == 0 {
+		return
+	}
+	first := &node[T]{value: values[0]}
+	last := first
+	for _, value := range values[1:] {
+		last.next = &node[T]{value: value}
+		last = last.next
+	}
+	if L.tail == nil {
+		L.head = first
+	} else {
+		L.tail.next = first
+	}
+	L.tail = last
+	L.length += len(values)
+	enforceCapacity(L)
+}
+
+// InsertAll 从 position 开始按顺序插入 values，先把 values 链接成一条链再整体接入，
+// position 取值范围与 Append 相同为 [0, length]，values 为空时不做任何事
+func InsertAll[T any](L *LinkedList[T], position int, values []T) error {
+	defer invalidateIndex(L)
+	if position < 0 || position > L.length {
+		return ErrInvalidPosition
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	first := &node[T]{value: values[0]}
+	last := first
+	for _, value := range values[1:] {
+		last.next = &node[T]{value: value}
+		last = last.next
+	}
+	if position == 0 {
+		last.next = L.head
+		L.head = first
+	} else {
+		prev := nodeAt(L, position-1)
+		last.next = prev.next
+		prev.next = first
+	}
+	if last.next == nil {
+		L.tail = last
+	}
+	L.length += len(values)
+	if position == 0 {
+		enforceCapacityFront(L)
+	} else {
+		enforceCapacity(L)
+	}
+	return nil
+}
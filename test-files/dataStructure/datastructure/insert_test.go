@@ -0,0 +1,148 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestInsertAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		target int
+		want   []int
+	}{
+		{"head", 1, []int{1, 0, 2, 3}},
+		{"middle", 2, []int{1, 2, 0, 3}},
+		{"tail", 3, []int{1, 2, 3, 0}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3)
+		if !InsertAfter(L, tt.target, 0) {
+			t.Fatalf("%s: InsertAfter = false", tt.name)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestInsertBefore(t *testing.T) {
+	tests := []struct {
+		name   string
+		target int
+		want   []int
+	}{
+		{"head", 1, []int{0, 1, 2, 3}},
+		{"middle", 2, []int{1, 0, 2, 3}},
+		{"tail", 3, []int{1, 2, 0, 3}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3)
+		if !InsertBefore(L, tt.target, 0) {
+			t.Fatalf("%s: InsertBefore = false", tt.name)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if L.head.value != tt.want[0] {
+			t.Errorf("%s: head = %d, want %d", tt.name, L.head.value, tt.want[0])
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestInsertRelativeMissing(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	if InsertAfter(L, 9, 0) || InsertBefore(L, 9, 0) {
+		t.Fatal("target 不存在时应返回 false")
+	}
+	if InsertAfter(NewLinkedList[int](), 1, 0) || InsertBefore(NewLinkedList[int](), 1, 0) {
+		t.Fatal("空链表应返回 false")
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("未找到 target 时不应修改链表: %v", got)
+	}
+}
+
+func TestAppendSlice(t *testing.T) {
+	L := NewLinkedList[int]()
+	AppendSlice(L, []int{1, 2})
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("ToSlice = %v, want [1 2]", got)
+	}
+	validateLength(t, L)
+
+	AppendSlice(L, []int{3, 4, 5})
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("ToSlice = %v, want [1 2 3 4 5]", got)
+	}
+	if L.tail.value != 5 || Len(L) != 5 {
+		t.Fatalf("tail = %d, Len = %d", L.tail.value, Len(L))
+	}
+	validateLength(t, L)
+}
+
+func TestAppendSliceEmpty(t *testing.T) {
+	L := newListOf(1)
+	tail := L.tail
+	AppendSlice(L, nil)
+	AppendSlice(L, []int{})
+	if L.tail != tail || Len(L) != 1 {
+		t.Fatal("追加空切片不应修改链表")
+	}
+	empty := NewLinkedList[int]()
+	AppendSlice(empty, nil)
+	if !ListIsEmpty(empty) || empty.tail != nil {
+		t.Fatal("空链表追加空切片后应仍为空")
+	}
+}
+
+func TestInsertAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		position int
+		values   []int
+		want     []int
+	}{
+		{"头部", 0, []int{7, 8}, []int{7, 8, 1, 2, 3}},
+		{"中间", 1, []int{7, 8, 9}, []int{1, 7, 8, 9, 2, 3}},
+		{"尾部", 3, []int{7, 8}, []int{1, 2, 3, 7, 8}},
+		{"单个值", 2, []int{7}, []int{1, 2, 7, 3}},
+		{"空切片", 1, nil, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3)
+		if err := InsertAll(L, tt.position, tt.values); err != nil {
+			t.Fatalf("%s: InsertAll 返回错误: %v", tt.name, err)
+		}
+		if got := collect(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: InsertAll = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestInsertAllEmptyList(t *testing.T) {
+	L := NewLinkedList[int]()
+	if err := InsertAll(L, 0, []int{1, 2}); err != nil {
+		t.Fatalf("InsertAll 返回错误: %v", err)
+	}
+	if got := collect(L); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("InsertAll = %v, want [1 2]", got)
+	}
+	validateLength(t, L)
+}
+
+func TestInsertAllInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, position := range []int{-1, 4} {
+		if err := InsertAll(L, position, []int{9}); !errors.Is(err, ErrInvalidPosition) {
+			t.Fatalf("InsertAll(%d) 错误 = %v, want ErrInvalidPosition", position, err)
+		}
+	}
+	if got := collect(L); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("无效位置不应修改链表: %v", got)
+	}
+}
@@ -0,0 +1,43 @@
+package datastructure
+
+import "iter"
+
+// All 返回按顺序产出 (下标, 值) 的迭代器，可用于 for i, v := range L.All()
+func (L *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for current := L.head; current != nil; current = current.next {
+			if !yield(index, current.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values 返回按顺序产出值的迭代器
+func (L *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := L.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+// Reversed 返回从尾到头产出值的迭代器，不修改链表。
+// 单向链表无法反向遍历，因此每次迭代开始时会先把节点指针收集到切片中，需要 O(n) 额外空间
+func Reversed[T any](L *LinkedList[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nodes := make([]*node[T], 0, L.length)
+		for current := L.head; current != nil; current = current.next {
+			nodes = append(nodes, current)
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	L := newListOf("a", "b", "c")
+	var indices []int
+	var values []string
+	for i, v := range L.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) {
+		t.Fatalf("indices = %v", indices)
+	}
+	if !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Fatalf("values = %v", values)
+	}
+}
+
+func TestAllBreak(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5)
+	calls := 0
+	for i := range L.All() {
+		calls++
+		if i == 1 {
+			break
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("break 后应停止遍历, calls = %d", calls)
+	}
+}
+
+func TestValues(t *testing.T) {
+	L := newListOf(3, 1, 2)
+	var values []int
+	for v := range L.Values() {
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(values, []int{3, 1, 2}) {
+		t.Fatalf("values = %v", values)
+	}
+
+	calls := 0
+	for range L.Values() {
+		calls++
+		break
+	}
+	if calls != 1 {
+		t.Fatalf("break 后应停止遍历, calls = %d", calls)
+	}
+}
+
+func TestIterEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	for range L.All() {
+		t.Fatal("空链表不应产出元素")
+	}
+	for range L.Values() {
+		t.Fatal("空链表不应产出元素")
+	}
+}
+
+func TestReversed(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	var values []int
+	for v := range Reversed(L) {
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(values, []int{4, 3, 2, 1}) {
+		t.Fatalf("Reversed = %v, want [4 3 2 1]", values)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("Reversed 不应修改链表: %v", got)
+	}
+	validateLength(t, L)
+}
+
+func TestReversedBreak(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	calls := 0
+	for v := range Reversed(L) {
+		calls++
+		if v == 3 {
+			break
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("break 后应停止遍历, calls = %d", calls)
+	}
+	for range Reversed(NewLinkedList[int]()) {
+		t.Fatal("空链表不应产出元素")
+	}
+}
@@ -1,27 +1,61 @@
 package datastructure
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+)
 
-type node struct {
-	value int
-	next  *node
+var (
+	// ErrInvalidPosition 位置参数超出允许范围
+	ErrInvalidPosition = errors.New("无效的位置参数")
+	// ErrEmptyList 对空链表执行需要元素的操作
+	ErrEmptyList = errors.New("链表为空")
+	// ErrTypeMismatch 外部数据中的元素类型与链表元素类型不一致
+	ErrTypeMismatch = errors.New("元素类型不匹配")
+	// ErrLengthMismatch 要求等长的两个链表长度不同
+	ErrLengthMismatch = errors.New("链表长度不一致")
+	// ErrInvalidSize 分块、窗口等大小参数不是正数
+	ErrInvalidSize = errors.New("无效的大小参数")
+	// ErrCorruptedList 链表的 head、tail、length 之间的不变量被破坏
+	ErrCorruptedList = errors.New("链表结构已损坏")
+	// ErrNotFound 链表中不存在要查找的值
+	ErrNotFound = errors.New("未找到指定的值")
+)
+
+type node[T any] struct {
+	value T
+	next  *node[T]
 }
 
-type linkedList struct {
-	head   *node
-	tail   *node
+// LinkedList 单向链表，元素类型由类型参数 T 决定
+type LinkedList[T any] struct {
+	head   *node[T]
+	tail   *node[T]
 	length int
+	pool   *sync.Pool
+
+	indexed   bool
+	cacheNode *node[T]
+	cachePos  int
+
+	capacity  int
+	evictions int
+
+	observers []func(event string, index int, value T)
 }
 
-func NewLinkedList() *linkedList {
-	L := new(linkedList)
+func NewLinkedList[T any]() *LinkedList[T] {
+	L := new(LinkedList[T])
 	L.head = nil
 	L.tail = nil
 	L.length = 0
 	return L
 }
 
-func ListIsEmpty(L *linkedList) bool {
+func ListIsEmpty[T any](L *LinkedList[T]) bool {
 	if L.head == nil {
 		return true
 	} else {
@@ -29,75 +63,449 @@ func ListIsEmpty(L *linkedList) bool {
 	}
 }
 
-func Append(L *linkedList, value int, position int) bool {
-	newNode := new(node)
-	newNode.value = value
-    if position < 0 || position > L.length {
-        return fmt.Errorf("无效的位置参数")
-    }
-	if position == 0 {
+// Len 返回链表长度，时间复杂度 O(1)
+func Len[T any](L *LinkedList[T]) int {
+	return L.length
+}
+
+// Append 在 position 处插入 value，与 InsertAt 相同，保留以兼容已有调用
+func Append[T any](L *LinkedList[T], value T, position int) error {
+	return InsertAt(L, value, position)
+}
+
+// Prepend 在头部插入 value，时间复杂度 O(1)
+func Prepend[T any](L *LinkedList[T], value T) {
+	_ = InsertAt(L, value, 0)
+}
+
+// AppendTail 借助 tail 指针在尾部插入 value，时间复杂度 O(1)
+func AppendTail[T any](L *LinkedList[T], value T) {
+	_ = InsertAt(L, value, L.length)
+}
+
+// InsertAt 在 position 处插入 value，position 取值范围为 [0, length]。
+// position == length 时直接借助 tail 指针插入，时间复杂度 O(1)
+func InsertAt[T any](L *LinkedList[T], value T, position int) error {
+	if position < 0 || position > L.length {
+		return ErrInvalidPosition
+	}
+	newNode := allocNode(L, value)
+	switch {
+	case position == 0:
 		newNode.next = L.head
 		L.head = newNode
 		if L.tail == nil {
 			L.tail = newNode
 		}
-		L.length++
-		return true
+	case position == L.length:
+		L.tail.next = newNode
+		L.tail = newNode
+	default:
+		current := nodeAt(L, position-1)
+		newNode.next = current.next
+		current.next = newNode
 	}
-	current := L.head
-	for i := 0; i < position-1; i++ {
+	L.length++
+	// 先使缓存失效再通知，回调中按位置读取链表时才不会用到过期的缓存
+	invalidateIndex(L)
+	notify(L, EventAppend, position, value)
+	evictOverflow(L, position == 0, true)
+	return nil
+}
+
+// String 以 [1 -> 2 -> 3] 的形式返回链表内容，空链表为 []
+func (L *LinkedList[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	for current := L.head; current != nil; current = current.next {
+		if current != L.head {
+			b.WriteString(" -> ")
+		}
+		fmt.Fprint(&b, current.value)
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func PrintList[T any](L *LinkedList[T]) {
+	fmt.Println(L.String())
+}
+
+// DeleteNode 删除 position 处的节点，position 取值范围为 [0, length)
+func DeleteNode[T any](L *LinkedList[T], position int) error {
+	_, err := DeleteNodeReturn(L, position)
+	return err
+}
+
+// DeleteNodeReturn 与 DeleteNode 相同，但在同一次遍历中返回被删除节点的值
+func DeleteNodeReturn[T any](L *LinkedList[T], position int) (T, error) {
+	if position < 0 || position >= L.length {
+		var zero T
+		return zero, ErrInvalidPosition
+	}
+	var removed *node[T]
+	if position == 0 {
+		removed = L.head
+		L.head = removed.next
+		if L.head == nil {
+			L.tail = nil
+		}
+	} else {
+		current := nodeAt(L, position-1)
+		removed = current.next
+		current.next = removed.next
+		if current.next == nil {
+			L.tail = current
+		}
+	}
+	L.length--
+	invalidateIndex(L)
+	value := removed.value
+	releaseNode(L, removed)
+	notify(L, EventDelete, position, value)
+	return value, nil
+}
+
+// nodeAt 返回 position 处的节点，调用方需保证 position 合法。
+// 启用位置缓存时会从缓存节点开始查找并更新缓存
+func nodeAt[T any](L *LinkedList[T], position int) *node[T] {
+	current, start := L.head, 0
+	if L.indexed && L.cacheNode != nil && L.cachePos <= position {
+		current, start = L.cacheNode, L.cachePos
+	}
+	for i := start; i < position; i++ {
 		current = current.next
 	}
-	newNode.next = current.next
-	current.next = newNode
+	if L.indexed {
+		L.cacheNode, L.cachePos = current, position
+	}
+	return current
+}
 
-	if newNode.next == nil {
-		L.tail = newNode
+// Get 返回 position 处的值，position 取值范围为 [0, length)
+func Get[T any](L *LinkedList[T], position int) (T, error) {
+	if position < 0 || position >= L.length {
+		var zero T
+		return zero, ErrInvalidPosition
+	}
+	return nodeAt(L, position).value, nil
+}
+
+// Front 返回头节点的值，时间复杂度 O(1)，空链表返回 ErrEmptyList
+func Front[T any](L *LinkedList[T]) (T, error) {
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return L.head.value, nil
+}
+
+// Back 借助 tail 指针返回尾节点的值，时间复杂度 O(1)，空链表返回 ErrEmptyList
+func Back[T any](L *LinkedList[T]) (T, error) {
+	if L.tail == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return L.tail.value, nil
+}
+
+// Set 将 position 处的值改为 value，position 取值范围为 [0, length)
+func Set[T any](L *LinkedList[T], position int, value T) error {
+	if position < 0 || position >= L.length {
+		return ErrInvalidPosition
 	}
+	nodeAt(L, position).value = value
+	notify(L, EventSet, position, value)
+	return nil
+}
 
-	if L.tail == current {
+// PushFront 在头部插入 value，时间复杂度 O(1)
+func PushFront[T any](L *LinkedList[T], value T) {
+	defer invalidateIndex(L)
+	newNode := allocNode(L, value)
+	newNode.next = L.head
+	L.head = newNode
+	if L.tail == nil {
 		L.tail = newNode
 	}
 	L.length++
+	enforceCapacityFront(L)
+}
 
-	return true
+// PushBack 借助 tail 指针在尾部插入 value，时间复杂度 O(1)
+func PushBack[T any](L *LinkedList[T], value T) {
+	defer invalidateIndex(L)
+	newNode := allocNode(L, value)
+	if L.tail == nil {
+		L.head = newNode
+	} else {
+		L.tail.next = newNode
+	}
+	L.tail = newNode
+	L.length++
+	enforceCapacity(L)
 }
 
-func PrintList(L *linkedList) {
-	currentNode := L.head
-	for currentNode != nil {
-		fmt.Println(currentNode.value)
-		currentNode = currentNode.next
+// PopFront 删除并返回头节点的值，时间复杂度 O(1)
+func PopFront[T any](L *LinkedList[T]) (T, error) {
+	defer invalidateIndex(L)
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
 	}
+	removed := L.head
+	value := removed.value
+	L.head = removed.next
+	if L.head == nil {
+		L.tail = nil
+	}
+	L.length--
+	releaseNode(L, removed)
+	return value, nil
 }
 
-func DeleteNode(L *linkedList, position int) error {
-    if position < 0 || position >= L.length {
-        return fmt.Errorf("无效的位置参数")
-    }
-    if position == 0 {
-        L.head = L.head.next
-        if L.head == nil {
-            L.tail = nil
-        }
-        L.length--
-        return nil
-    }
-    current := L.head
-    for i := 0; i < position-1; i++ {
-        current = current.next
-    }
-    current.next = current.next.next
-    if current.next == nil {
-        L.tail = current
-    }
-    L.length--
-    return nil
+// PopBack 删除并返回尾节点的值。
+// 单向链表需要从头遍历找到新的尾节点，时间复杂度 O(n)
+func PopBack[T any](L *LinkedList[T]) (T, error) {
+	defer invalidateIndex(L)
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	removed := L.tail
+	value := removed.value
+	if L.head == L.tail {
+		L.head = nil
+		L.tail = nil
+		L.length--
+		releaseNode(L, removed)
+		return value, nil
+	}
+	current := L.head
+	for current.next != L.tail {
+		current = current.next
+	}
+	current.next = nil
+	L.tail = current
+	L.length--
+	releaseNode(L, removed)
+	return value, nil
 }
 
+// Clear 清空链表以便复用，旧节点交由垃圾回收
+func Clear[T any](L *LinkedList[T]) {
+	L.head = nil
+	L.tail = nil
+	L.length = 0
+	invalidateIndex(L)
+	var zero T
+	notify(L, EventClear, -1, zero)
+}
+
+// Clone 深拷贝链表，返回的新链表与原链表不共享节点
+func Clone[T any](L *LinkedList[T]) *LinkedList[T] {
+	clone := NewLinkedList[T]()
+	for current := L.head; current != nil; current = current.next {
+		PushBack(clone, current.value)
+	}
+	return clone
+}
+
+// Reverse 原地反转链表，等同于 ReverseIterative
+func Reverse[T any](L *LinkedList[T]) {
+	ReverseIterative(L)
+}
+
+// reverseRecursionLimit ReverseRecursive 允许递归处理的最大长度，超过时改用迭代实现以免栈过深
+const reverseRecursionLimit = 10000
+
+// ReverseRecursive 通过递归重新链接节点来原地反转链表，递归深度等于链表长度。
+// 长度超过 reverseRecursionLimit 时退回到 ReverseIterative
+func ReverseRecursive[T any](L *LinkedList[T]) {
+	defer invalidateIndex(L)
+	if L.length > reverseRecursionLimit {
+		ReverseIterative(L)
+		return
+	}
+	L.head, L.tail = reverseNodes(L.head), L.head
+}
+
+// reverseNodes 反转以 n 开头的节点链并返回新的头节点
+func reverseNodes[T any](n *node[T]) *node[T] {
+	if n == nil || n.next == nil {
+		return n
+	}
+	head := reverseNodes(n.next)
+	n.next.next = n
+	n.next = nil
+	return head
+}
+
+// ReverseIterative 原地反转链表，只遍历一次，交换 head 与 tail
+func ReverseIterative[T any](L *LinkedList[T]) {
+	defer invalidateIndex(L)
+	var prev *node[T]
+	current := L.head
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	L.head, L.tail = L.tail, L.head
+}
+
+// Rotate 将前 k 个元素移到尾部（左旋），k 为负数时右旋，k 超过长度时取模
+func Rotate[T any](L *LinkedList[T], k int) {
+	defer invalidateIndex(L)
+	if L.length < 2 {
+		return
+	}
+	k = ((k % L.length) + L.length) % L.length
+	if k == 0 {
+		return
+	}
+	newTail := nodeAt(L, k-1)
+	L.tail.next = L.head
+	L.head = newTail.next
+	newTail.next = nil
+	L.tail = newTail
+}
+
+// RotateToValue 旋转链表，使第一个等于 value 的节点成为新的 head，
+// 原 tail 接到原 head 之前，并在新 head 的前驱处断开。value 不存在时返回 ErrNotFound
+func RotateToValue[T comparable](L *LinkedList[T], value T) error {
+	defer invalidateIndex(L)
+	var prev *node[T]
+	current := L.head
+	for current != nil && current.value != value {
+		prev, current = current, current.next
+	}
+	if current == nil {
+		return fmt.Errorf("%w: %v", ErrNotFound, value)
+	}
+	if prev == nil {
+		return nil
+	}
+	L.tail.next = L.head
+	L.head = current
+	prev.next = nil
+	L.tail = prev
+	return nil
+}
+
+// Swap 通过重新链接 next 指针交换位置 i 与 j 处的节点（而不仅是值）
+func Swap[T any](L *LinkedList[T], i, j int) error {
+	defer invalidateIndex(L)
+	if i < 0 || i >= L.length || j < 0 || j >= L.length {
+		return ErrInvalidPosition
+	}
+	if i == j {
+		return nil
+	}
+	if i > j {
+		i, j = j, i
+	}
+	var prevI *node[T]
+	if i > 0 {
+		prevI = nodeAt(L, i-1)
+	}
+	nodeI := L.head
+	if prevI != nil {
+		nodeI = prevI.next
+	}
+	prevJ := nodeI
+	for k := i + 1; k < j; k++ {
+		prevJ = prevJ.next
+	}
+	nodeJ := prevJ.next
+
+	if prevI == nil {
+		L.head = nodeJ
+	} else {
+		prevI.next = nodeJ
+	}
+	if nodeI.next == nodeJ {
+		nodeI.next = nodeJ.next
+		nodeJ.next = nodeI
+	} else {
+		prevJ.next = nodeI
+		nodeI.next, nodeJ.next = nodeJ.next, nodeI.next
+	}
+	if L.tail == nodeJ {
+		L.tail = nodeI
+	}
+	return nil
+}
+
+// SwapValues 只交换位置 i 与 j 处的值，不改变链接关系，比 Swap 代价更小
+func SwapValues[T any](L *LinkedList[T], i, j int) error {
+	if i < 0 || i >= L.length || j < 0 || j >= L.length {
+		return ErrInvalidPosition
+	}
+	if i == j {
+		return nil
+	}
+	a, b := nodeAt(L, min(i, j)), nodeAt(L, max(i, j))
+	a.value, b.value = b.value, a.value
+	return nil
+}
+
+// MoveToFront 将 position 处的节点摘下并接到头部，position 取值范围为 [0, length)，
+// 节点已在头部时不做任何事
+func MoveToFront[T any](L *LinkedList[T], position int) error {
+	defer invalidateIndex(L)
+	if position < 0 || position >= L.length {
+		return ErrInvalidPosition
+	}
+	if position == 0 {
+		return nil
+	}
+	prev := nodeAt(L, position-1)
+	moved := prev.next
+	prev.next = moved.next
+	if moved == L.tail {
+		L.tail = prev
+	}
+	moved.next = L.head
+	L.head = moved
+	return nil
+}
+
+// MoveToBack 将 position 处的节点摘下并接到尾部，position 取值范围为 [0, length)，
+// 节点已在尾部时不做任何事
+func MoveToBack[T any](L *LinkedList[T], position int) error {
+	defer invalidateIndex(L)
+	if position < 0 || position >= L.length {
+		return ErrInvalidPosition
+	}
+	if position == L.length-1 {
+		return nil
+	}
+	var moved *node[T]
+	if position == 0 {
+		moved = L.head
+		L.head = moved.next
+	} else {
+		prev := nodeAt(L, position-1)
+		moved = prev.next
+		prev.next = moved.next
+	}
+	moved.next = nil
+	L.tail.next = moved
+	L.tail = moved
+	return nil
+}
+
+// Shuffle 使用 r 对链表做 Fisher–Yates 洗牌，传入固定种子的 r 可得到可复现的结果
+func Shuffle[T any](L *LinkedList[T], r *rand.Rand) {
+	for i := L.length - 1; i > 0; i-- {
+		_ = SwapValues(L, i, r.Intn(i+1))
+	}
+}
 
 func main() {
-	L := NewLinkedList()
+	L := NewLinkedList[int]()
 	Append(L, 1, 0)
 	Append(L, 2, 1)
 	Append(L, 3, 2)
@@ -0,0 +1,781 @@
+package datastructure
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+// collect 按从头到尾的顺序收集链表中的值
+func collect[T any](L *LinkedList[T]) []T {
+	values := []T{}
+	for current := L.head; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return values
+}
+
+// validateLength 遍历链表，检查节点数与 length 一致、最后一个节点为 tail
+func validateLength[T any](t *testing.T, L *LinkedList[T]) {
+	t.Helper()
+	count := 0
+	var last *node[T]
+	for current := L.head; current != nil; current = current.next {
+		count++
+		last = current
+	}
+	if count != L.length {
+		t.Fatalf("节点数 %d 与 length %d 不一致", count, L.length)
+	}
+	if last != L.tail {
+		t.Fatal("最后一个节点与 tail 不一致")
+	}
+}
+
+// newListOf 依次在尾部追加 values 构造链表
+func newListOf[T any](values ...T) *LinkedList[T] {
+	L := NewLinkedList[T]()
+	for i, v := range values {
+		if err := Append(L, v, i); err != nil {
+			panic(err)
+		}
+	}
+	return L
+}
+
+func TestNewLinkedListIsEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	if !ListIsEmpty(L) {
+		t.Fatal("新建链表应为空")
+	}
+	if L.head != nil || L.tail != nil || L.length != 0 {
+		t.Fatalf("新建链表字段未初始化: %+v", L)
+	}
+}
+
+func TestAppendInt(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	if err := Append(L, 0, 0); err != nil {
+		t.Fatalf("头部插入失败: %v", err)
+	}
+	if err := Append(L, 9, 2); err != nil {
+		t.Fatalf("中间插入失败: %v", err)
+	}
+	if got, want := collect(L), []int{0, 1, 9, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.length != 5 || L.tail.value != 3 {
+		t.Fatalf("length = %d, tail = %v", L.length, L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestAppendString(t *testing.T) {
+	L := newListOf("a", "b", "c")
+	if got, want := collect(L), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.head.value != "a" || L.tail.value != "c" {
+		t.Fatalf("head = %q, tail = %q", L.head.value, L.tail.value)
+	}
+}
+
+func TestAppendStruct(t *testing.T) {
+	L := newListOf(point{1, 2}, point{3, 4})
+	if err := Append(L, point{0, 0}, 0); err != nil {
+		t.Fatalf("头部插入失败: %v", err)
+	}
+	want := []point{{0, 0}, {1, 2}, {3, 4}}
+	if got := collect(L); !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.tail.value != (point{3, 4}) {
+		t.Fatalf("tail = %v", L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestAppendAtTail(t *testing.T) {
+	L := newListOf(1, 2)
+	if err := Append(L, 3, L.length); err != nil {
+		t.Fatalf("尾部插入失败: %v", err)
+	}
+	if L.tail.value != 3 || L.length != 3 {
+		t.Fatalf("tail = %v, length = %d", L.tail.value, L.length)
+	}
+}
+
+func TestAppendTailLarge(t *testing.T) {
+	const size = 10000
+	L := NewLinkedList[int]()
+	for i := 0; i < size; i++ {
+		if err := Append(L, i, L.length); err != nil {
+			t.Fatalf("尾部插入 %d 失败: %v", i, err)
+		}
+		if L.tail.value != i || L.tail.next != nil {
+			t.Fatalf("插入 %d 后 tail = %v", i, L.tail.value)
+		}
+	}
+	validateLength(t, L)
+	i := 0
+	for current := L.head; current != nil; current = current.next {
+		if current.value != i {
+			t.Fatalf("位置 %d 的值 = %d", i, current.value)
+		}
+		i++
+	}
+}
+
+func TestAppendMiddleAfterTailFastPath(t *testing.T) {
+	L := NewLinkedList[int]()
+	for i := 0; i < 4; i++ {
+		Append(L, i*10, L.length)
+	}
+	Append(L, 5, 1)
+	Append(L, 25, 4)
+	Append(L, 40, L.length)
+	if got, want := collect(L), []int{0, 5, 10, 20, 25, 30, 40}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestPrependAndAppendTail(t *testing.T) {
+	L := NewLinkedList[int]()
+	AppendTail(L, 2)
+	Prepend(L, 1)
+	AppendTail(L, 3)
+	Prepend(L, 0)
+	if got, want := collect(L), []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.head.value != 0 || L.tail.value != 3 {
+		t.Fatalf("head = %v, tail = %v", L.head.value, L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestInsertAt(t *testing.T) {
+	L := newListOf(1, 3)
+	if err := InsertAt(L, 2, 1); err != nil {
+		t.Fatalf("InsertAt 返回错误: %v", err)
+	}
+	if got, want := collect(L), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	for _, position := range []int{-1, 4} {
+		if err := InsertAt(L, 0, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("InsertAt(position=%d) 应返回 ErrInvalidPosition, got %v", position, err)
+		}
+	}
+	validateLength(t, L)
+}
+
+func TestAppendInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, position := range []int{-1, -10, 4, 100} {
+		if err := Append(L, 0, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Append(position=%d) 应返回错误", position)
+		}
+	}
+	if got, want := collect(L), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("无效插入不应修改链表: %v", got)
+	}
+	if L.length != 3 {
+		t.Fatalf("length = %d, want 3", L.length)
+	}
+}
+
+func TestAppendEmptyList(t *testing.T) {
+	L := NewLinkedList[int]()
+	if err := Append(L, 1, 1); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("空链表在位置 1 插入应返回错误")
+	}
+	if err := Append(L, 1, 0); err != nil {
+		t.Fatalf("空链表在位置 0 插入失败: %v", err)
+	}
+	if L.head != L.tail || L.length != 1 {
+		t.Fatalf("单元素链表 head 与 tail 应相同: %+v", L)
+	}
+}
+
+func TestDeleteNodeInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, position := range []int{-1, 3, 10} {
+		if err := DeleteNode(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("DeleteNode(position=%d) 应返回错误", position)
+		}
+	}
+	if err := DeleteNode(NewLinkedList[int](), 0); !errors.Is(err, ErrInvalidPosition) {
+		t.Error("空链表删除应返回错误")
+	}
+}
+
+func TestDeleteNode(t *testing.T) {
+	L := newListOf("a", "b", "c", "d")
+	if err := DeleteNode(L, 0); err != nil {
+		t.Fatalf("删除头节点失败: %v", err)
+	}
+	if err := DeleteNode(L, 2); err != nil {
+		t.Fatalf("删除尾节点失败: %v", err)
+	}
+	if got, want := collect(L), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.tail.value != "c" || L.length != 2 {
+		t.Fatalf("tail = %q, length = %d", L.tail.value, L.length)
+	}
+	if err := DeleteNode(L, 2); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatal("越界删除应返回错误")
+	}
+	validateLength(t, L)
+}
+
+func TestDeleteNodeStruct(t *testing.T) {
+	L := newListOf(point{1, 1})
+	if err := DeleteNode(L, 0); err != nil {
+		t.Fatalf("删除失败: %v", err)
+	}
+	if !ListIsEmpty(L) || L.tail != nil || L.length != 0 {
+		t.Fatalf("删除唯一节点后链表应为空: %+v", L)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5)
+	oldHead := L.head
+	Reverse(L)
+	if got, want := collect(L), []int{5, 4, 3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.tail != oldHead {
+		t.Fatal("反转后 tail 应指向原来的头节点")
+	}
+	if L.tail.next != nil {
+		t.Fatal("反转后 tail.next 应为 nil")
+	}
+	if L.length != 5 {
+		t.Fatalf("length = %d, want 5", L.length)
+	}
+	validateLength(t, L)
+}
+
+func TestReverseEmptyAndSingle(t *testing.T) {
+	empty := NewLinkedList[int]()
+	Reverse(empty)
+	if !ListIsEmpty(empty) || empty.tail != nil || empty.length != 0 {
+		t.Fatalf("空链表反转后应仍为空: %+v", empty)
+	}
+
+	single := newListOf("only")
+	Reverse(single)
+	if single.head != single.tail || single.head.value != "only" || single.length != 1 {
+		t.Fatalf("单元素链表反转后应不变: %+v", single)
+	}
+}
+
+func TestReverseStrategies(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, size := range []int{0, 1, 2, 3, 10, 100, reverseRecursionLimit, reverseRecursionLimit + 1} {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = r.Intn(1000)
+		}
+		want := slices.Clone(values)
+		slices.Reverse(want)
+
+		iterative, recursive := FromSlice(values), FromSlice(values)
+		ReverseIterative(iterative)
+		ReverseRecursive(recursive)
+		if got := ToSlice(iterative); !reflect.DeepEqual(got, want) {
+			t.Fatalf("size %d: ReverseIterative = %v, want %v", size, got, want)
+		}
+		if !Equals(iterative, recursive) {
+			t.Fatalf("size %d: ReverseRecursive 与 ReverseIterative 结果不一致", size)
+		}
+		validateLength(t, iterative)
+		validateLength(t, recursive)
+	}
+}
+
+func TestGet(t *testing.T) {
+	L := newListOf(10, 20, 30, 40)
+	for position, want := range []int{10, 20, 30, 40} {
+		got, err := Get(L, position)
+		if err != nil {
+			t.Fatalf("Get(%d) 返回错误: %v", position, err)
+		}
+		if got != want {
+			t.Errorf("Get(%d) = %d, want %d", position, got, want)
+		}
+	}
+	for _, position := range []int{-1, 4, 10} {
+		if _, err := Get(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Get(%d) 应返回错误", position)
+		}
+	}
+}
+
+func TestFrontBack(t *testing.T) {
+	tests := []struct {
+		L           *LinkedList[int]
+		front, back int
+	}{
+		{newListOf(7), 7, 7},
+		{newListOf(1, 2, 3), 1, 3},
+	}
+	for _, tt := range tests {
+		if v, err := Front(tt.L); err != nil || v != tt.front {
+			t.Errorf("Front(%v) = (%d, %v), want %d", tt.L, v, err, tt.front)
+		}
+		if v, err := Back(tt.L); err != nil || v != tt.back {
+			t.Errorf("Back(%v) = (%d, %v), want %d", tt.L, v, err, tt.back)
+		}
+	}
+
+	L := NewLinkedList[int]()
+	if _, err := Front(L); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("空链表 Front 应返回 ErrEmptyList, got %v", err)
+	}
+	if _, err := Back(L); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("空链表 Back 应返回 ErrEmptyList, got %v", err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	L := newListOf("a", "b", "c")
+	for position, value := range []string{"x", "y", "z"} {
+		if err := Set(L, position, value); err != nil {
+			t.Fatalf("Set(%d) 返回错误: %v", position, err)
+		}
+	}
+	if got, want := collect(L), []string{"x", "y", "z"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.tail.value != "z" || L.length != 3 {
+		t.Fatalf("tail = %q, length = %d", L.tail.value, L.length)
+	}
+	for _, position := range []int{-1, 3} {
+		if err := Set(L, position, "bad"); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Set(%d) 应返回错误", position)
+		}
+	}
+	if got, want := collect(L), []string{"x", "y", "z"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("越界 Set 不应修改链表: %v", got)
+	}
+}
+
+func TestLen(t *testing.T) {
+	empty := NewLinkedList[int]()
+	if got := Len(empty); got != 0 {
+		t.Errorf("Len(empty) = %d, want 0", got)
+	}
+	validateLength(t, empty)
+
+	single := newListOf(1)
+	if got := Len(single); got != 1 {
+		t.Errorf("Len(single) = %d, want 1", got)
+	}
+	validateLength(t, single)
+
+	multi := newListOf(1, 2, 3, 4)
+	if err := DeleteNode(multi, 3); err != nil {
+		t.Fatalf("删除失败: %v", err)
+	}
+	if got := Len(multi); got != 3 {
+		t.Errorf("Len(multi) = %d, want 3", got)
+	}
+	validateLength(t, multi)
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		list fmt.Stringer
+		want string
+	}{
+		{NewLinkedList[int](), "[]"},
+		{newListOf(1), "[1]"},
+		{newListOf(1, 2, 3), "[1 -> 2 -> 3]"},
+		{newListOf("a", "b"), "[a -> b]"},
+	}
+	for _, tt := range tests {
+		if got := tt.list.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPushFrontAndBack(t *testing.T) {
+	L := NewLinkedList[int]()
+	PushBack(L, 2)
+	PushFront(L, 1)
+	PushBack(L, 3)
+	if got, want := collect(L), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.head.value != 1 || L.tail.value != 3 {
+		t.Fatalf("head = %d, tail = %d", L.head.value, L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestPopEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	if _, err := PopFront(L); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 PopFront 应返回错误")
+	}
+	if _, err := PopBack(L); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 PopBack 应返回错误")
+	}
+}
+
+func TestPopSingle(t *testing.T) {
+	for name, pop := range map[string]func(*LinkedList[int]) (int, error){
+		"PopFront": PopFront[int],
+		"PopBack":  PopBack[int],
+	} {
+		L := newListOf(7)
+		value, err := pop(L)
+		if err != nil || value != 7 {
+			t.Fatalf("%s = (%d, %v), want (7, nil)", name, value, err)
+		}
+		if L.head != nil || L.tail != nil || L.length != 0 {
+			t.Fatalf("%s 后链表应为空: %+v", name, L)
+		}
+	}
+}
+
+func TestPopMulti(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	if value, err := PopFront(L); err != nil || value != 1 {
+		t.Fatalf("PopFront = (%d, %v), want (1, nil)", value, err)
+	}
+	if value, err := PopBack(L); err != nil || value != 4 {
+		t.Fatalf("PopBack = (%d, %v), want (4, nil)", value, err)
+	}
+	if got, want := collect(L), []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("collect = %v, want %v", got, want)
+	}
+	if L.head.value != 2 || L.tail.value != 3 || L.tail.next != nil {
+		t.Fatalf("head = %d, tail = %d", L.head.value, L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestClone(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	clone := Clone(L)
+	validateLength(t, clone)
+	if got := collect(clone); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("collect(clone) = %v", got)
+	}
+	if clone.head == L.head || clone.tail == L.tail {
+		t.Fatal("克隆链表不应共享节点")
+	}
+
+	if err := Set(clone, 0, 100); err != nil {
+		t.Fatalf("Set 失败: %v", err)
+	}
+	if err := Append(clone, 4, Len(clone)); err != nil {
+		t.Fatalf("Append 失败: %v", err)
+	}
+	if got := collect(L); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("修改克隆不应影响原链表: %v", got)
+	}
+	if L.tail.value != 3 || L.length != 3 {
+		t.Fatalf("原链表 tail = %d, length = %d", L.tail.value, L.length)
+	}
+
+	if err := DeleteNode(L, 0); err != nil {
+		t.Fatalf("DeleteNode 失败: %v", err)
+	}
+	if got := collect(clone); !reflect.DeepEqual(got, []int{100, 2, 3, 4}) {
+		t.Fatalf("修改原链表不应影响克隆: %v", got)
+	}
+}
+
+func TestCloneEmpty(t *testing.T) {
+	clone := Clone(NewLinkedList[string]())
+	if !ListIsEmpty(clone) || clone.tail != nil || clone.length != 0 {
+		t.Fatalf("空链表克隆应为空: %+v", clone)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{-6, []int{5, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4, 5)
+		Rotate(L, tt.k)
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Rotate(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestRotateShort(t *testing.T) {
+	empty := NewLinkedList[int]()
+	Rotate(empty, 3)
+	if !ListIsEmpty(empty) {
+		t.Fatal("空链表旋转后应仍为空")
+	}
+	single := newListOf(1)
+	Rotate(single, -4)
+	validateLength(t, single)
+}
+
+func TestRotateToValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  []int
+	}{
+		{"中间值", 3, []int{3, 4, 5, 1, 2}},
+		{"头节点", 1, []int{1, 2, 3, 4, 5}},
+		{"尾节点", 5, []int{5, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4, 5)
+		if err := RotateToValue(L, tt.value); err != nil {
+			t.Fatalf("%s: RotateToValue 返回错误: %v", tt.name, err)
+		}
+		if got := collect(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: RotateToValue = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestRotateToValueNotFound(t *testing.T) {
+	for _, L := range []*LinkedList[int]{newListOf(1, 2, 3), NewLinkedList[int]()} {
+		if err := RotateToValue(L, 9); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("RotateToValue(%v, 9) 错误 = %v, want ErrNotFound", L, err)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	tests := []struct {
+		name string
+		i, j int
+		want []int
+	}{
+		{"adjacent", 1, 2, []int{1, 3, 2, 4, 5}},
+		{"adjacent reversed args", 2, 1, []int{1, 3, 2, 4, 5}},
+		{"non-adjacent", 1, 3, []int{1, 4, 3, 2, 5}},
+		{"head and tail", 0, 4, []int{5, 2, 3, 4, 1}},
+		{"head adjacent", 0, 1, []int{2, 1, 3, 4, 5}},
+		{"tail adjacent", 3, 4, []int{1, 2, 3, 5, 4}},
+		{"same index", 2, 2, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4, 5)
+		nodeI, nodeJ := nodeAt(L, tt.i), nodeAt(L, tt.j)
+		if err := Swap(L, tt.i, tt.j); err != nil {
+			t.Fatalf("%s: Swap 返回错误: %v", tt.name, err)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if nodeAt(L, tt.i) != nodeJ || nodeAt(L, tt.j) != nodeI {
+			t.Errorf("%s: 应交换节点本身而不是值", tt.name)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestSwapTwoElements(t *testing.T) {
+	L := newListOf("a", "b")
+	if err := Swap(L, 0, 1); err != nil {
+		t.Fatalf("Swap 返回错误: %v", err)
+	}
+	if L.head.value != "b" || L.tail.value != "a" {
+		t.Fatalf("head = %q, tail = %q", L.head.value, L.tail.value)
+	}
+	validateLength(t, L)
+}
+
+func TestSwapInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, pair := range [][2]int{{-1, 0}, {0, 3}, {5, 1}} {
+		if err := Swap(L, pair[0], pair[1]); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Swap(%d, %d) err = %v, want ErrInvalidPosition", pair[0], pair[1], err)
+		}
+	}
+}
+
+func TestClear(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	Clear(L)
+	if !ListIsEmpty(L) || L.tail != nil || Len(L) != 0 {
+		t.Fatalf("Clear 后链表应为空: %+v", L)
+	}
+	for i, v := range []int{7, 8} {
+		if err := Append(L, v, i); err != nil {
+			t.Fatalf("Clear 后 Append 失败: %v", err)
+		}
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{7, 8}) {
+		t.Fatalf("ToSlice = %v, want [7 8]", got)
+	}
+	validateLength(t, L)
+}
+
+func TestSwapValues(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	head, tail := L.head, L.tail
+	if err := SwapValues(L, 0, 3); err != nil {
+		t.Fatalf("SwapValues 返回错误: %v", err)
+	}
+	if err := SwapValues(L, 2, 1); err != nil {
+		t.Fatalf("SwapValues 返回错误: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{4, 3, 2, 1}) {
+		t.Fatalf("ToSlice = %v, want [4 3 2 1]", got)
+	}
+	if L.head != head || L.tail != tail {
+		t.Fatal("SwapValues 不应改变节点链接")
+	}
+	if err := SwapValues(L, 0, 4); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatalf("SwapValues(0, 4) err = %v, want ErrInvalidPosition", err)
+	}
+}
+
+func TestMoveToFrontAndBack(t *testing.T) {
+	tests := []struct {
+		name     string
+		move     func(*LinkedList[int], int) error
+		position int
+		want     []int
+	}{
+		{"中间节点移到头部", MoveToFront[int], 2, []int{3, 1, 2, 4, 5}},
+		{"尾节点移到头部", MoveToFront[int], 4, []int{5, 1, 2, 3, 4}},
+		{"头节点移到头部", MoveToFront[int], 0, []int{1, 2, 3, 4, 5}},
+		{"中间节点移到尾部", MoveToBack[int], 2, []int{1, 2, 4, 5, 3}},
+		{"头节点移到尾部", MoveToBack[int], 0, []int{2, 3, 4, 5, 1}},
+		{"尾节点移到尾部", MoveToBack[int], 4, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4, 5)
+		if err := tt.move(L, tt.position); err != nil {
+			t.Fatalf("%s: 返回错误: %v", tt.name, err)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestMoveInvalidPosition(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	for _, position := range []int{-1, 3} {
+		if err := MoveToFront(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("MoveToFront(%d) 应返回 ErrInvalidPosition, got %v", position, err)
+		}
+		if err := MoveToBack(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("MoveToBack(%d) 应返回 ErrInvalidPosition, got %v", position, err)
+		}
+	}
+	if err := MoveToBack(NewLinkedList[int](), 0); !errors.Is(err, ErrInvalidPosition) {
+		t.Errorf("空链表 MoveToBack 应返回 ErrInvalidPosition, got %v", err)
+	}
+}
+
+func TestShuffleReproducible(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	a, b := FromSlice(values), FromSlice(values)
+	Shuffle(a, rand.New(rand.NewSource(42)))
+	Shuffle(b, rand.New(rand.NewSource(42)))
+	if !Equals(a, b) {
+		t.Fatalf("相同种子应得到相同排列: %v vs %v", a, b)
+	}
+	if Equals(a, FromSlice(values)) {
+		t.Fatalf("洗牌后不应与原顺序相同: %v", a)
+	}
+	validateLength(t, a)
+}
+
+func TestShufflePermutation(t *testing.T) {
+	values := []int{5, 3, 3, 1, 9, 9, 9, 0}
+	L := FromSlice(values)
+	Shuffle(L, rand.New(rand.NewSource(7)))
+	got := ToSlice(L)
+	slices.Sort(got)
+	want := slices.Clone(values)
+	slices.Sort(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("洗牌结果不是原链表的排列: %v", ToSlice(L))
+	}
+
+	empty := NewLinkedList[int]()
+	Shuffle(empty, rand.New(rand.NewSource(1)))
+	if Len(empty) != 0 {
+		t.Fatal("空链表洗牌后应仍为空")
+	}
+}
+
+func TestDeleteNodeReturn(t *testing.T) {
+	L := newListOf(10, 20, 30, 40, 50)
+	tests := []struct {
+		name     string
+		position int
+		want     int
+		rest     []int
+	}{
+		{"head", 0, 10, []int{20, 30, 40, 50}},
+		{"tail", 3, 50, []int{20, 30, 40}},
+		{"middle", 1, 30, []int{20, 40}},
+	}
+	for _, tt := range tests {
+		got, err := DeleteNodeReturn(L, tt.position)
+		if err != nil || got != tt.want {
+			t.Fatalf("%s: DeleteNodeReturn = (%d, %v), want (%d, nil)", tt.name, got, err, tt.want)
+		}
+		if !reflect.DeepEqual(ToSlice(L), tt.rest) {
+			t.Fatalf("%s: ToSlice = %v, want %v", tt.name, ToSlice(L), tt.rest)
+		}
+		validateLength(t, L)
+	}
+	for _, position := range []int{-1, 2} {
+		if _, err := DeleteNodeReturn(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("DeleteNodeReturn(%d) err = %v, want ErrInvalidPosition", position, err)
+		}
+	}
+}
+
+func BenchmarkAppendTail(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		L := NewLinkedList[int]()
+		for i := 0; i < 1000; i++ {
+			Append(L, i, L.length)
+		}
+	}
+}
+
+func BenchmarkAppendMiddle(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		L := NewLinkedList[int]()
+		for i := 0; i < 1000; i++ {
+			Append(L, i, L.length/2)
+		}
+	}
+}
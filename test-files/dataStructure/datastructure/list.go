@@ -0,0 +1,36 @@
+package datastructure
+
+// List 单向链表与双向链表共同实现的接口，调用方可以互换具体实现
+type List[T any] interface {
+	Append(value T, position int) error
+	DeleteNode(position int) error
+	Len() int
+	Get(position int) (T, error)
+	ToSlice() []T
+}
+
+var (
+	_ List[int] = (*LinkedList[int])(nil)
+	_ List[int] = (*DoublyLinkedList[int])(nil)
+	_ List[int] = (*ConcurrentList[int])(nil)
+)
+
+func (L *LinkedList[T]) Append(value T, position int) error {
+	return Append(L, value, position)
+}
+
+func (L *LinkedList[T]) DeleteNode(position int) error {
+	return DeleteNode(L, position)
+}
+
+func (L *LinkedList[T]) Len() int {
+	return Len(L)
+}
+
+func (L *LinkedList[T]) Get(position int) (T, error) {
+	return Get(L, position)
+}
+
+func (L *LinkedList[T]) ToSlice() []T {
+	return ToSlice(L)
+}
@@ -0,0 +1,70 @@
+package datastructure
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache 基于双向链表的 LRU 缓存，链表头部为最近使用的条目，尾部为最久未使用的条目。
+// 配合 map 实现 O(1) 的 Get 和 Put
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	order    *DoublyLinkedList[lruEntry[K, V]]
+	items    map[K]*dnode[lruEntry[K, V]]
+}
+
+// NewLRUCache 创建容量为 capacity 的 LRU 缓存，capacity 不为正数时缓存不保存任何条目
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		order:    NewDoublyLinkedList[lruEntry[K, V]](),
+		items:    make(map[K]*dnode[lruEntry[K, V]]),
+	}
+}
+
+// Get 返回 key 对应的值并将其标记为最近使用
+func (C *LRUCache[K, V]) Get(key K) (V, bool) {
+	n, ok := C.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	C.moveToFront(n)
+	return n.value.value, true
+}
+
+// Put 写入或更新 key 对应的值并将其标记为最近使用，超出容量时淘汰最久未使用的条目
+func (C *LRUCache[K, V]) Put(key K, value V) {
+	if C.capacity <= 0 {
+		return
+	}
+	if n, ok := C.items[key]; ok {
+		n.value.value = value
+		C.moveToFront(n)
+		return
+	}
+	n := &dnode[lruEntry[K, V]]{value: lruEntry[K, V]{key: key, value: value}}
+	C.order.insertBefore(n, C.order.head)
+	C.items[key] = n
+	if C.order.length > C.capacity {
+		oldest := C.order.tail
+		C.order.unlink(oldest)
+		delete(C.items, oldest.value.key)
+	}
+}
+
+func (C *LRUCache[K, V]) Len() int {
+	return C.order.length
+}
+
+func (C *LRUCache[K, V]) Capacity() int {
+	return C.capacity
+}
+
+func (C *LRUCache[K, V]) moveToFront(n *dnode[lruEntry[K, V]]) {
+	if C.order.head == n {
+		return
+	}
+	C.order.unlink(n)
+	C.order.insertBefore(n, C.order.head)
+}
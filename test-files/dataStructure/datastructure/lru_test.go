@@ -0,0 +1,77 @@
+package datastructure
+
+import "testing"
+
+// lruKeys 返回从最近使用到最久未使用的键顺序
+func lruKeys[K comparable, V any](C *LRUCache[K, V]) []K {
+	var keys []K
+	for _, e := range C.order.ToSlice() {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	C := NewLRUCache[int, string](2)
+	C.Put(1, "a")
+	C.Put(2, "b")
+	C.Put(3, "c")
+	if _, ok := C.Get(1); ok {
+		t.Fatal("最久未使用的键 1 应被淘汰")
+	}
+	for key, want := range map[int]string{2: "b", 3: "c"} {
+		if v, ok := C.Get(key); !ok || v != want {
+			t.Errorf("Get(%d) = (%q, %v), want (%q, true)", key, v, ok, want)
+		}
+	}
+	if C.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", C.Len())
+	}
+	validateDoubly(t, C.order)
+}
+
+func TestLRUCacheGetRefreshes(t *testing.T) {
+	C := NewLRUCache[string, int](2)
+	C.Put("a", 1)
+	C.Put("b", 2)
+	if _, ok := C.Get("a"); !ok {
+		t.Fatal("Get(a) 未命中")
+	}
+	C.Put("c", 3)
+	if _, ok := C.Get("b"); ok {
+		t.Fatal("Get 刷新 a 之后应淘汰 b")
+	}
+	if _, ok := C.Get("a"); !ok {
+		t.Fatal("a 不应被淘汰")
+	}
+	if keys := lruKeys(C); len(keys) != 2 || keys[0] != "a" || keys[1] != "c" {
+		t.Fatalf("最近使用顺序 = %v, want [a c]", keys)
+	}
+	validateDoubly(t, C.order)
+}
+
+func TestLRUCacheUpdateExisting(t *testing.T) {
+	C := NewLRUCache[int, int](2)
+	C.Put(1, 10)
+	C.Put(2, 20)
+	C.Put(1, 11)
+	if C.Len() != 2 {
+		t.Fatalf("更新已有键不应增加大小, Len = %d", C.Len())
+	}
+	if v, _ := C.Get(1); v != 11 {
+		t.Fatalf("Get(1) = %d, want 11", v)
+	}
+	C.Put(3, 30)
+	if _, ok := C.Get(2); ok {
+		t.Fatal("更新键 1 后应淘汰键 2")
+	}
+	validateDoubly(t, C.order)
+}
+
+func TestLRUCacheZeroCapacity(t *testing.T) {
+	C := NewLRUCache[int, int](0)
+	C.Put(1, 1)
+	if _, ok := C.Get(1); ok || C.Len() != 0 {
+		t.Fatal("容量为 0 的缓存不应保存条目")
+	}
+}
@@ -0,0 +1,33 @@
+package datastructure
+
+// 以下 *Neg 函数支持类似 Python 的负数下标：-1 表示最后一个元素，-length 表示第一个元素。
+// 非负下标的含义与对应的普通函数相同，超出范围时同样返回 ErrInvalidPosition
+
+// normalizePosition 将负数下标换算为从头开始的下标
+func normalizePosition(position, length int) int {
+	if position < 0 {
+		return position + length
+	}
+	return position
+}
+
+// GetNeg 与 Get 相同，但支持负数下标
+func GetNeg[T any](L *LinkedList[T], position int) (T, error) {
+	return Get(L, normalizePosition(position, L.length))
+}
+
+// SetNeg 与 Set 相同，但支持负数下标
+func SetNeg[T any](L *LinkedList[T], position int, value T) error {
+	return Set(L, normalizePosition(position, L.length), value)
+}
+
+// DeleteNeg 与 DeleteNode 相同，但支持负数下标
+func DeleteNeg[T any](L *LinkedList[T], position int) error {
+	return DeleteNode(L, normalizePosition(position, L.length))
+}
+
+// AppendNeg 与 Append 相同，但支持负数下标。
+// 插入位置共有 length+1 个，因此 -1 表示插入到尾部，-(length+1) 表示插入到头部
+func AppendNeg[T any](L *LinkedList[T], value T, position int) error {
+	return Append(L, value, normalizePosition(position, L.length+1))
+}
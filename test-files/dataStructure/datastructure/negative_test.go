@@ -0,0 +1,103 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetNeg(t *testing.T) {
+	L := newListOf(10, 20, 30)
+	tests := []struct {
+		position int
+		want     int
+	}{
+		{-1, 30},
+		{-2, 20},
+		{-3, 10},
+		{0, 10},
+		{2, 30},
+	}
+	for _, tt := range tests {
+		if got, err := GetNeg(L, tt.position); err != nil || got != tt.want {
+			t.Errorf("GetNeg(%d) = (%d, %v), want (%d, nil)", tt.position, got, err, tt.want)
+		}
+	}
+	for _, position := range []int{-4, 3} {
+		if _, err := GetNeg(L, position); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("GetNeg(%d) err = %v, want ErrInvalidPosition", position, err)
+		}
+	}
+}
+
+func TestSetNeg(t *testing.T) {
+	L := newListOf(1, 2, 3)
+	if err := SetNeg(L, -1, 9); err != nil {
+		t.Fatalf("SetNeg(-1) 失败: %v", err)
+	}
+	if L.tail.value != 9 {
+		t.Fatalf("SetNeg(-1) 应修改尾节点, tail = %d", L.tail.value)
+	}
+	if err := SetNeg(L, -3, 7); err != nil {
+		t.Fatalf("SetNeg(-3) 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{7, 2, 9}) {
+		t.Fatalf("ToSlice = %v, want [7 2 9]", got)
+	}
+	if err := SetNeg(L, -4, 0); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatalf("SetNeg(-4) err = %v, want ErrInvalidPosition", err)
+	}
+}
+
+func TestDeleteNeg(t *testing.T) {
+	L := newListOf(1, 2, 3, 4)
+	if err := DeleteNeg(L, -1); err != nil {
+		t.Fatalf("DeleteNeg(-1) 失败: %v", err)
+	}
+	if L.tail.value != 3 {
+		t.Fatalf("删除 -1 后 tail = %d, want 3", L.tail.value)
+	}
+	if err := DeleteNeg(L, -3); err != nil {
+		t.Fatalf("DeleteNeg(-3) 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Fatalf("ToSlice = %v, want [2 3]", got)
+	}
+	validateLength(t, L)
+	if err := DeleteNeg(L, -3); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatalf("DeleteNeg(-3) err = %v, want ErrInvalidPosition", err)
+	}
+}
+
+func TestAppendNeg(t *testing.T) {
+	L := newListOf(1, 2)
+	if err := AppendNeg(L, 3, -1); err != nil {
+		t.Fatalf("AppendNeg(-1) 失败: %v", err)
+	}
+	if err := AppendNeg(L, 0, -4); err != nil {
+		t.Fatalf("AppendNeg(-4) 失败: %v", err)
+	}
+	if err := AppendNeg(L, 9, -2); err != nil {
+		t.Fatalf("AppendNeg(-2) 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{0, 1, 2, 9, 3}) {
+		t.Fatalf("ToSlice = %v, want [0 1 2 9 3]", got)
+	}
+	validateLength(t, L)
+	if err := AppendNeg(L, 0, -7); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatalf("AppendNeg(-7) err = %v, want ErrInvalidPosition", err)
+	}
+}
+
+func TestNegativeOnEmpty(t *testing.T) {
+	L := NewLinkedList[int]()
+	if _, err := GetNeg(L, -1); !errors.Is(err, ErrInvalidPosition) {
+		t.Fatalf("GetNeg(empty, -1) err = %v", err)
+	}
+	if err := AppendNeg(L, 1, -1); err != nil {
+		t.Fatalf("AppendNeg(empty, -1) 失败: %v", err)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("ToSlice = %v, want [1]", got)
+	}
+}
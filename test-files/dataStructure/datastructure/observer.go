@@ -0,0 +1,23 @@
+package datastructure
+
+// OnChange 回调收到的事件名称
+const (
+	EventAppend = "append"
+	EventDelete = "delete"
+	EventSet    = "set"
+	EventClear  = "clear"
+)
+
+// OnChange 注册变更回调，Append、DeleteNode、Set、Clear 执行成功后会按注册顺序调用所有回调。
+// index 为受影响的位置，value 为插入、删除或写入的值；Clear 事件的 index 为 -1，value 为零值
+// 回调执行时链表（包括位置缓存）已处于变更后的状态，可以在回调中读取链表
+func OnChange[T any](L *LinkedList[T], f func(event string, index int, value T)) {
+	L.observers = append(L.observers, f)
+}
+
+// notify 按注册顺序通知所有回调
+func notify[T any](L *LinkedList[T], event string, index int, value T) {
+	for _, f := range L.observers {
+		f(event, index, value)
+	}
+}
@@ -0,0 +1,107 @@
+package datastructure
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestOnChangeEvents(t *testing.T) {
+	L := NewLinkedList[int]()
+	var events []string
+	OnChange(L, func(event string, index, value int) {
+		events = append(events, fmt.Sprintf("%s:%d:%d", event, index, value))
+	})
+
+	_ = Append(L, 1, 0)
+	_ = Append(L, 2, 1)
+	_ = Append(L, 3, 1)
+	_ = Set(L, 0, 10)
+	_ = DeleteNode(L, 2)
+	_ = DeleteNode(L, 0)
+	Clear(L)
+
+	want := []string{
+		"append:0:1",
+		"append:1:2",
+		"append:1:3",
+		"set:0:10",
+		"delete:2:2",
+		"delete:0:10",
+		"clear:-1:0",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("events = %v, want %v", events, want)
+	}
+}
+
+func TestOnChangeFailedOperations(t *testing.T) {
+	L := newListOf(1)
+	calls := 0
+	OnChange(L, func(string, int, int) { calls++ })
+	_ = Append(L, 0, 5)
+	_ = DeleteNode(L, 5)
+	_ = Set(L, -1, 0)
+	if calls != 0 {
+		t.Fatalf("失败的操作不应触发回调, calls = %d", calls)
+	}
+}
+
+func TestOnChangeOrder(t *testing.T) {
+	L := NewLinkedList[string]()
+	var order []int
+	for i := 1; i <= 3; i++ {
+		OnChange(L, func(string, int, string) { order = append(order, i) })
+	}
+	_ = Append(L, "x", 0)
+	if !reflect.DeepEqual(order, []int{1, 2, 3}) {
+		t.Fatalf("回调顺序 = %v, want [1 2 3]", order)
+	}
+}
+
+func TestOnChangePooled(t *testing.T) {
+	L := NewPooledList[string]()
+	_ = Append(L, "gone", 0)
+	var deleted string
+	OnChange(L, func(event string, _ int, value string) {
+		if event == EventDelete {
+			deleted = value
+		}
+	})
+	_ = DeleteNode(L, 0)
+	if deleted != "gone" {
+		t.Fatalf("删除事件应携带被删除的值, got %q", deleted)
+	}
+}
+
+func TestOnChangeIndexedListReadsFreshValues(t *testing.T) {
+	L := NewIndexedList[int]()
+	for _, v := range []int{10, 20, 30} {
+		PushBack(L, v)
+	}
+	var got []string
+	OnChange(L, func(event string, index, value int) {
+		if Len(L) < 3 {
+			got = append(got, fmt.Sprintf("%s:%v", event, ToSlice(L)))
+			return
+		}
+		v, err := Get(L, 2)
+		if err != nil {
+			t.Errorf("%s 回调中 Get(2) 返回错误: %v", event, err)
+			return
+		}
+		got = append(got, fmt.Sprintf("%s:%d", event, v))
+	})
+
+	_, _ = Get(L, 2)
+	_ = Append(L, 5, 0)
+	_, _ = Get(L, 3)
+	_ = DeleteNode(L, 1)
+	_, _ = Get(L, 2)
+	Clear(L)
+
+	want := []string{"append:20", "delete:30", "clear:[]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("回调中读取到的值 = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+package datastructure
+
+// PersistentList 不可变的持久化单向链表，nil 表示空链表。
+// 每次 Prepend 都返回指向旧链表的新头节点，新旧版本共享后缀节点，
+// 由于节点创建后不再修改，旧版本始终有效，适合实现撤销历史
+type PersistentList[T any] struct {
+	value  T
+	next   *PersistentList[T]
+	length int
+}
+
+// Prepend 返回以 value 为头、以 P 为后缀的新版本，时间复杂度 O(1)
+func (P *PersistentList[T]) Prepend(value T) *PersistentList[T] {
+	return &PersistentList[T]{value: value, next: P, length: P.Len() + 1}
+}
+
+// Head 返回头元素，空链表返回 ErrEmptyList
+func (P *PersistentList[T]) Head() (T, error) {
+	if P == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return P.value, nil
+}
+
+// Tail 返回去掉头元素后的版本，不复制任何节点；空链表的 Tail 仍为空链表
+func (P *PersistentList[T]) Tail() *PersistentList[T] {
+	if P == nil {
+		return nil
+	}
+	return P.next
+}
+
+func (P *PersistentList[T]) Len() int {
+	if P == nil {
+		return 0
+	}
+	return P.length
+}
+
+func (P *PersistentList[T]) ToSlice() []T {
+	values := make([]T, 0, P.Len())
+	for current := P; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return values
+}
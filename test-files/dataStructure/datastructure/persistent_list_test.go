@@ -0,0 +1,65 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPersistentListVersions(t *testing.T) {
+	var v0 *PersistentList[int]
+	v1 := v0.Prepend(1)
+	v2 := v1.Prepend(2)
+	v3 := v2.Prepend(3)
+	branch := v2.Prepend(9)
+
+	tests := []struct {
+		name    string
+		version *PersistentList[int]
+		want    []int
+	}{
+		{"v0", v0, []int{}},
+		{"v1", v1, []int{1}},
+		{"v2", v2, []int{2, 1}},
+		{"v3", v3, []int{3, 2, 1}},
+		{"branch", branch, []int{9, 2, 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.version.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.version.Len(); got != len(tt.want) {
+			t.Errorf("%s: Len = %d, want %d", tt.name, got, len(tt.want))
+		}
+	}
+}
+
+func TestPersistentListSharing(t *testing.T) {
+	var empty *PersistentList[string]
+	v1 := empty.Prepend("a")
+	v2 := v1.Prepend("b")
+	branch := v1.Prepend("c")
+	if v2.Tail() != v1 || branch.Tail() != v1 {
+		t.Fatal("新版本应共享旧版本的节点而不是复制")
+	}
+	if v2.Tail().Tail() != nil {
+		t.Fatal("v2.Tail().Tail() 应为空链表")
+	}
+}
+
+func TestPersistentListHead(t *testing.T) {
+	var empty *PersistentList[int]
+	if _, err := empty.Head(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("空链表 Head err = %v, want ErrEmptyList", err)
+	}
+	if empty.Tail() != nil {
+		t.Fatal("空链表的 Tail 应为 nil")
+	}
+	v := empty.Prepend(5).Prepend(6)
+	if head, err := v.Head(); err != nil || head != 6 {
+		t.Fatalf("Head = (%d, %v), want (6, nil)", head, err)
+	}
+	if head, _ := v.Tail().Head(); head != 5 {
+		t.Fatalf("Tail().Head() = %d, want 5", head)
+	}
+}
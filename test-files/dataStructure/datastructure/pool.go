@@ -0,0 +1,35 @@
+package datastructure
+
+import "sync"
+
+// NewPooledList 创建使用 sync.Pool 复用节点的链表。
+// DeleteNode 和 Pop 系列函数删除的节点会被清零后放回池中，供后续插入复用，
+// 适合频繁插入删除的场景
+func NewPooledList[T any]() *LinkedList[T] {
+	L := NewLinkedList[T]()
+	L.pool = &sync.Pool{
+		New: func() any { return new(node[T]) },
+	}
+	return L
+}
+
+// allocNode 分配一个值为 value 的新节点，启用节点池时从池中取出
+func allocNode[T any](L *LinkedList[T], value T) *node[T] {
+	if L.pool == nil {
+		return &node[T]{value: value}
+	}
+	n := L.pool.Get().(*node[T])
+	n.value = value
+	return n
+}
+
+// releaseNode 回收已从链表中摘除的节点，清零后放回池中以免残留旧值
+func releaseNode[T any](L *LinkedList[T], n *node[T]) {
+	if L.pool == nil {
+		return
+	}
+	var zero T
+	n.value = zero
+	n.next = nil
+	L.pool.Put(n)
+}
@@ -0,0 +1,87 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPooledListBehavesLikeList(t *testing.T) {
+	L := NewPooledList[int]()
+	for i := 0; i < 5; i++ {
+		if err := Append(L, i, i); err != nil {
+			t.Fatalf("Append 失败: %v", err)
+		}
+	}
+	if err := DeleteNode(L, 0); err != nil {
+		t.Fatalf("DeleteNode 失败: %v", err)
+	}
+	if err := DeleteNode(L, 3); err != nil {
+		t.Fatalf("DeleteNode 失败: %v", err)
+	}
+	PushFront(L, 10)
+	PushBack(L, 20)
+	if got, want := ToSlice(L), []int{10, 1, 2, 3, 20}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestPooledListZeroesReleasedNodes(t *testing.T) {
+	L := NewPooledList[*point]()
+	PushBack(L, &point{1, 1})
+	PushBack(L, &point{2, 2})
+	PushBack(L, &point{3, 3})
+
+	head, tail := L.head, L.tail
+	if err := DeleteNode(L, 0); err != nil {
+		t.Fatalf("DeleteNode 失败: %v", err)
+	}
+	if _, err := PopBack(L); err != nil {
+		t.Fatalf("PopBack 失败: %v", err)
+	}
+	for _, n := range []*node[*point]{head, tail} {
+		if n.value != nil || n.next != nil {
+			t.Fatalf("回收的节点应被清零: %+v", n)
+		}
+	}
+
+	for i := 0; i < 4; i++ {
+		PushBack(L, &point{i, i})
+	}
+	for current := L.head; current != nil; current = current.next {
+		if current.value == nil {
+			t.Fatal("复用的节点不应残留空值")
+		}
+	}
+	if got := Len(L); got != 5 {
+		t.Fatalf("Len = %d, want 5", got)
+	}
+	if L.tail.next != nil {
+		t.Fatal("复用的尾节点 next 应为 nil")
+	}
+	validateLength(t, L)
+}
+
+func benchmarkChurn(b *testing.B, L *LinkedList[int]) {
+	for i := 0; i < 64; i++ {
+		PushBack(L, i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Append(L, i, 1); err != nil {
+			b.Fatal(err)
+		}
+		if err := DeleteNode(L, 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkChurnUnpooled(b *testing.B) {
+	benchmarkChurn(b, NewLinkedList[int]())
+}
+
+func BenchmarkChurnPooled(b *testing.B) {
+	benchmarkChurn(b, NewPooledList[int]())
+}
@@ -0,0 +1,37 @@
+package datastructure
+
+// Queue 基于单向链表的队列，尾部入队、头部出队，时间复杂度均为 O(1)
+type Queue[T any] struct {
+	list *LinkedList[T]
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{list: NewLinkedList[T]()}
+}
+
+// Enqueue 借助 tail 指针在队尾加入元素
+func (Q *Queue[T]) Enqueue(value T) {
+	PushBack(Q.list, value)
+}
+
+// Dequeue 移除并返回队首元素，队列为空时返回 ErrEmptyList
+func (Q *Queue[T]) Dequeue() (T, error) {
+	return PopFront(Q.list)
+}
+
+// Peek 返回队首元素但不移除，队列为空时返回 ErrEmptyList
+func (Q *Queue[T]) Peek() (T, error) {
+	if Q.list.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return Q.list.head.value, nil
+}
+
+func (Q *Queue[T]) Len() int {
+	return Len(Q.list)
+}
+
+func (Q *Queue[T]) IsEmpty() bool {
+	return ListIsEmpty(Q.list)
+}
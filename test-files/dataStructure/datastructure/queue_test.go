@@ -0,0 +1,58 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestQueueFIFO(t *testing.T) {
+	Q := NewQueue[int]()
+	for i := 1; i <= 3; i++ {
+		Q.Enqueue(i)
+	}
+	if v, err := Q.Peek(); err != nil || v != 1 {
+		t.Fatalf("Peek = (%d, %v), want (1, nil)", v, err)
+	}
+	var out []int
+	for !Q.IsEmpty() {
+		v, err := Q.Dequeue()
+		if err != nil {
+			t.Fatalf("Dequeue 失败: %v", err)
+		}
+		out = append(out, v)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(out, want) {
+		t.Fatalf("出队顺序 = %v, want %v", out, want)
+	}
+}
+
+func TestQueueDrainAndRefill(t *testing.T) {
+	Q := NewQueue[string]()
+	Q.Enqueue("a")
+	if _, err := Q.Dequeue(); err != nil {
+		t.Fatalf("Dequeue 失败: %v", err)
+	}
+	if Q.list.head != nil || Q.list.tail != nil {
+		t.Fatal("队列清空后 head/tail 应为 nil")
+	}
+	Q.Enqueue("b")
+	Q.Enqueue("c")
+	if Q.list.tail.value != "c" || Q.Len() != 2 {
+		t.Fatalf("重新入队后 tail = %q, Len = %d", Q.list.tail.value, Q.Len())
+	}
+	validateLength(t, Q.list)
+	if v, _ := Q.Dequeue(); v != "b" {
+		t.Fatalf("Dequeue = %q, want b", v)
+	}
+}
+
+func TestQueueEmpty(t *testing.T) {
+	Q := NewQueue[int]()
+	if _, err := Q.Dequeue(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("Dequeue(empty) err = %v, want ErrEmptyList", err)
+	}
+	if _, err := Q.Peek(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("Peek(empty) err = %v, want ErrEmptyList", err)
+	}
+}
@@ -0,0 +1,120 @@
+package datastructure
+
+// removeWhere 删除满足 pred 的节点，最多删除 limit 个（limit < 0 表示不限），返回删除数量
+func removeWhere[T any](L *LinkedList[T], pred func(T) bool, limit int) int {
+	defer invalidateIndex(L)
+	removed := 0
+	var prev *node[T]
+	current := L.head
+	for current != nil && removed != limit {
+		next := current.next
+		if pred(current.value) {
+			if prev == nil {
+				L.head = next
+			} else {
+				prev.next = next
+			}
+			if current == L.tail {
+				L.tail = prev
+			}
+			L.length--
+			removed++
+		} else {
+			prev = current
+		}
+		current = next
+	}
+	return removed
+}
+
+// RemoveValue 删除第一个等于 value 的节点，返回是否删除
+func RemoveValue[T comparable](L *LinkedList[T], value T) bool {
+	return removeWhere(L, func(v T) bool { return v == value }, 1) == 1
+}
+
+// RemoveAll 删除所有等于 value 的节点，返回删除数量
+func RemoveAll[T comparable](L *LinkedList[T], value T) int {
+	return removeWhere(L, func(v T) bool { return v == value }, -1)
+}
+
+// DeleteIf 一次遍历删除所有满足 pred 的节点，返回删除数量
+func DeleteIf[T any](L *LinkedList[T], pred func(T) bool) int {
+	return removeWhere(L, pred, -1)
+}
+
+// Compact 删除所有等于零值的节点，返回删除数量
+func Compact[T comparable](L *LinkedList[T]) int {
+	var zero T
+	return RemoveAll(L, zero)
+}
+
+// CompactFunc 删除所有满足 isEmpty 的节点，返回删除数量
+func CompactFunc[T any](L *LinkedList[T], isEmpty func(T) bool) int {
+	return removeWhere(L, isEmpty, -1)
+}
+
+// RemoveDuplicates 借助 seen 集合删除重复值，只保留每个值第一次出现的节点，返回删除数量
+func RemoveDuplicates[T comparable](L *LinkedList[T]) int {
+	seen := make(map[T]struct{}, L.length)
+	return removeWhere(L, func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	}, -1)
+}
+
+// DeduplicateSorted 假定链表已经有序，一次遍历删除相邻的重复节点，返回删除数量。
+// 与 RemoveDuplicates 不同，它不需要额外的集合；链表无序时只会删除相邻的重复值
+func DeduplicateSorted[T comparable](L *LinkedList[T]) int {
+	defer invalidateIndex(L)
+	if L.head == nil {
+		return 0
+	}
+	removed := 0
+	current := L.head
+	for current.next != nil {
+		if current.next.value == current.value {
+			current.next = current.next.next
+			removed++
+		} else {
+			current = current.next
+		}
+	}
+	L.tail = current
+	L.length -= removed
+	return removed
+}
+
+// RemoveRange 删除 [start, end) 区间内的节点，要求 0 <= start <= end <= length
+func RemoveRange[T any](L *LinkedList[T], start, end int) error {
+	defer invalidateIndex(L)
+	if start < 0 || start > end || end > L.length {
+		return ErrInvalidPosition
+	}
+	if start == end {
+		return nil
+	}
+	var prev *node[T]
+	if start > 0 {
+		prev = nodeAt(L, start-1)
+	}
+	after := L.head
+	if prev != nil {
+		after = prev.next
+	}
+	for i := start; i < end; i++ {
+		after = after.next
+	}
+	if prev == nil {
+		L.head = after
+	} else {
+		prev.next = after
+	}
+	if after == nil {
+		L.tail = prev
+	}
+	L.length -= end - start
+	return nil
+}
@@ -0,0 +1,241 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRemoveValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  []int
+		tail  int
+	}{
+		{"head", 1, []int{2, 3, 4}, 4},
+		{"middle", 3, []int{1, 2, 4}, 4},
+		{"tail", 4, []int{1, 2, 3}, 3},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3, 4)
+		if !RemoveValue(L, tt.value) {
+			t.Fatalf("%s: RemoveValue(%d) = false", tt.name, tt.value)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if L.tail.value != tt.tail {
+			t.Fatalf("%s: tail = %d, want %d", tt.name, L.tail.value, tt.tail)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestRemoveValueFirstOnly(t *testing.T) {
+	L := newListOf(2, 1, 2)
+	if !RemoveValue(L, 2) {
+		t.Fatal("RemoveValue(2) = false")
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("ToSlice = %v, want [1 2]", got)
+	}
+	validateLength(t, L)
+}
+
+func TestRemoveValueMissing(t *testing.T) {
+	L := newListOf(1, 2)
+	if RemoveValue(L, 5) {
+		t.Fatal("RemoveValue(5) = true")
+	}
+	if RemoveValue(NewLinkedList[int](), 1) {
+		t.Fatal("空链表 RemoveValue = true")
+	}
+	if got := RemoveAll(L, 5); got != 0 {
+		t.Fatalf("RemoveAll(5) = %d, want 0", got)
+	}
+	validateLength(t, L)
+}
+
+func TestRemoveAll(t *testing.T) {
+	L := newListOf(7, 1, 7, 2, 7)
+	if got := RemoveAll(L, 7); got != 3 {
+		t.Fatalf("RemoveAll = %d, want 3", got)
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("ToSlice = %v, want [1 2]", got)
+	}
+	if L.head.value != 1 || L.tail.value != 2 {
+		t.Fatalf("head = %d, tail = %d", L.head.value, L.tail.value)
+	}
+	validateLength(t, L)
+
+	same := newListOf("x", "x")
+	if got := RemoveAll(same, "x"); got != 2 {
+		t.Fatalf("RemoveAll = %d, want 2", got)
+	}
+	if !ListIsEmpty(same) || same.tail != nil {
+		t.Fatalf("删除全部节点后链表应为空: %+v", same)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"首尾与中间的零", []int{0, 0, 1, 0, 2, 3, 0}, []int{1, 2, 3}},
+		{"全部为零", []int{0, 0, 0}, []int{}},
+		{"没有零", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"空链表", nil, []int{}},
+	}
+	for _, tt := range tests {
+		L := newListOf(tt.values...)
+		removed := Compact(L)
+		if got := collect(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: Compact = %v, want %v", tt.name, got, tt.want)
+		}
+		if removed != len(tt.values)-len(tt.want) {
+			t.Fatalf("%s: 删除数量 = %d, want %d", tt.name, removed, len(tt.values)-len(tt.want))
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	L := newListOf("", " ", "a", "\t", "b", "")
+	removed := CompactFunc(L, func(s string) bool { return strings.TrimSpace(s) == "" })
+	if got, want := collect(L), []string{"a", "b"}; !reflect.DeepEqual(got, want) || removed != 4 {
+		t.Fatalf("CompactFunc = %v (删除 %d), want %v (删除 4)", got, removed, want)
+	}
+	validateLength(t, L)
+}
+
+func TestRemoveDuplicates(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		want    []int
+		removed int
+	}{
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"all duplicates", []int{5, 5, 5, 5}, []int{5}, 3},
+		{"scattered", []int{3, 1, 3, 2, 1, 4, 2}, []int{3, 1, 2, 4}, 3},
+		{"duplicate tail", []int{1, 2, 1}, []int{1, 2}, 1},
+		{"empty", []int{}, []int{}, 0},
+	}
+	for _, tt := range tests {
+		L := FromSlice(tt.values)
+		if got := RemoveDuplicates(L); got != tt.removed {
+			t.Errorf("%s: RemoveDuplicates = %d, want %d", tt.name, got, tt.removed)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestDeduplicateSorted(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"重复的连续段", []int{1, 1, 2, 3, 3, 3, 4, 5, 5}, []int{1, 2, 3, 4, 5}},
+		{"没有重复", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"全部相同", []int{7, 7, 7, 7}, []int{7}},
+		{"单个元素", []int{1}, []int{1}},
+		{"空链表", nil, []int{}},
+	}
+	for _, tt := range tests {
+		L, reference := newListOf(tt.values...), newListOf(tt.values...)
+		removed := DeduplicateSorted(L)
+		if got := collect(L); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: DeduplicateSorted = %v, want %v", tt.name, got, tt.want)
+		}
+		if removed != len(tt.values)-len(tt.want) {
+			t.Fatalf("%s: 删除数量 = %d, want %d", tt.name, removed, len(tt.values)-len(tt.want))
+		}
+		validateLength(t, L)
+
+		if want := RemoveDuplicates(reference); removed != want || !Equals(L, reference) {
+			t.Fatalf("%s: 结果与 RemoveDuplicates 不一致: %v vs %v", tt.name, L, reference)
+		}
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		want       []int
+	}{
+		{"middle", 1, 3, []int{0, 3, 4}},
+		{"head", 0, 2, []int{2, 3, 4}},
+		{"tail", 3, 5, []int{0, 1, 2}},
+		{"everything", 0, 5, []int{}},
+		{"empty span", 2, 2, []int{0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		L := newListOf(0, 1, 2, 3, 4)
+		if err := RemoveRange(L, tt.start, tt.end); err != nil {
+			t.Fatalf("%s: RemoveRange 返回错误: %v", tt.name, err)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		validateLength(t, L)
+	}
+}
+
+func TestRemoveRangeInvalid(t *testing.T) {
+	L := newListOf(0, 1, 2)
+	for _, bounds := range [][2]int{{-1, 1}, {2, 1}, {0, 4}, {4, 4}} {
+		if err := RemoveRange(L, bounds[0], bounds[1]); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("RemoveRange(%d, %d) err = %v, want ErrInvalidPosition", bounds[0], bounds[1], err)
+		}
+	}
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Fatalf("无效区间不应修改链表: %v", got)
+	}
+}
+
+func TestDeleteIf(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	tests := []struct {
+		name    string
+		values  []int
+		pred    func(int) bool
+		want    []int
+		removed int
+	}{
+		{"evens", []int{2, 1, 4, 3, 6}, isEven, []int{1, 3}, 3},
+		{"nothing", []int{1, 3, 5}, isEven, []int{1, 3, 5}, 0},
+		{"everything", []int{2, 4, 6}, isEven, []int{}, 3},
+		{"last node", []int{1, 3, 8}, isEven, []int{1, 3}, 1},
+	}
+	for _, tt := range tests {
+		L := FromSlice(tt.values)
+		calls := 0
+		got := DeleteIf(L, func(v int) bool {
+			calls++
+			return tt.pred(v)
+		})
+		if got != tt.removed {
+			t.Errorf("%s: DeleteIf = %d, want %d", tt.name, got, tt.removed)
+		}
+		if calls != len(tt.values) {
+			t.Errorf("%s: 应只遍历一次, calls = %d", tt.name, calls)
+		}
+		if !reflect.DeepEqual(ToSlice(L), tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, ToSlice(L), tt.want)
+		}
+		validateLength(t, L)
+		if len(tt.want) > 0 && L.tail.value != tt.want[len(tt.want)-1] {
+			t.Errorf("%s: tail = %d", tt.name, L.tail.value)
+		}
+	}
+}
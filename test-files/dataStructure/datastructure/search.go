@@ -0,0 +1,166 @@
+package datastructure
+
+// IndexOf 返回第一个等于 value 的节点下标，不存在时返回 -1
+func IndexOf[T comparable](L *LinkedList[T], value T) int {
+	index := 0
+	for current := L.head; current != nil; current = current.next {
+		if current.value == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// LastIndexOf 在一次正向遍历中记录最后一个等于 value 的节点下标，不存在时返回 -1
+func LastIndexOf[T comparable](L *LinkedList[T], value T) int {
+	last := -1
+	index := 0
+	for current := L.head; current != nil; current = current.next {
+		if current.value == value {
+			last = index
+		}
+		index++
+	}
+	return last
+}
+
+// Contains 判断链表中是否存在 value
+func Contains[T comparable](L *LinkedList[T], value T) bool {
+	return IndexOf(L, value) != -1
+}
+
+// ContainsFunc 判断链表中是否存在满足 pred 的值，不要求 T 满足 comparable
+func ContainsFunc[T any](L *LinkedList[T], pred func(T) bool) bool {
+	_, _, ok := Find(L, pred)
+	return ok
+}
+
+// Count 返回等于 value 的节点数量，不分配内存
+func Count[T comparable](L *LinkedList[T], value T) int {
+	count := 0
+	for current := L.head; current != nil; current = current.next {
+		if current.value == value {
+			count++
+		}
+	}
+	return count
+}
+
+// CountWhere 返回满足 pred 的值的个数
+func CountWhere[T any](L *LinkedList[T], pred func(T) bool) int {
+	count := 0
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			count++
+		}
+	}
+	return count
+}
+
+// Any 判断是否存在满足 pred 的值，遇到第一个满足的值即返回，空链表返回 false
+func Any[T any](L *LinkedList[T], pred func(T) bool) bool {
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All 判断是否所有值都满足 pred，遇到第一个不满足的值即返回，空链表返回 true
+func All[T any](L *LinkedList[T], pred func(T) bool) bool {
+	return !Any(L, func(v T) bool { return !pred(v) })
+}
+
+// None 判断是否没有值满足 pred，空链表返回 true
+func None[T any](L *LinkedList[T], pred func(T) bool) bool {
+	return !Any(L, pred)
+}
+
+// ReplaceAll 将所有等于 oldVal 的值替换为 newVal，返回替换次数，不改变链表结构
+func ReplaceAll[T comparable](L *LinkedList[T], oldVal, newVal T) int {
+	replaced := 0
+	for current := L.head; current != nil; current = current.next {
+		if current.value == oldVal {
+			current.value = newVal
+			replaced++
+		}
+	}
+	return replaced
+}
+
+// Find 返回第一个满足 pred 的值及其下标，未找到时 ok 为 false
+func Find[T any](L *LinkedList[T], pred func(T) bool) (value T, index int, ok bool) {
+	index = 0
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			return current.value, index, true
+		}
+		index++
+	}
+	var zero T
+	return zero, -1, false
+}
+
+// FindAll 按顺序返回所有满足 pred 的值
+func FindAll[T any](L *LinkedList[T], pred func(T) bool) []T {
+	matches := []T{}
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			matches = append(matches, current.value)
+		}
+	}
+	return matches
+}
+
+// FindLast 返回最后一个满足 pred 的值及其下标，未找到时 ok 为 false
+func FindLast[T any](L *LinkedList[T], pred func(T) bool) (value T, index int, ok bool) {
+	index = -1
+	i := 0
+	for current := L.head; current != nil; current = current.next {
+		if pred(current.value) {
+			value, index, ok = current.value, i, true
+		}
+		i++
+	}
+	return value, index, ok
+}
+
+// Middle 使用快慢指针返回中间节点的值，长度为偶数时返回靠后的那个
+func Middle[T any](L *LinkedList[T]) (T, error) {
+	if L.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	slow, fast := L.head, L.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	return slow.value, nil
+}
+
+// NthFromEnd 使用间隔 n 的双指针返回倒数第 n 个值，n = 1 为尾节点
+func NthFromEnd[T any](L *LinkedList[T], n int) (T, error) {
+	var zero T
+	if L.head == nil {
+		return zero, ErrEmptyList
+	}
+	if n <= 0 {
+		return zero, ErrInvalidPosition
+	}
+	lead := L.head
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return zero, ErrInvalidPosition
+		}
+		lead = lead.next
+	}
+	trail := L.head
+	for lead != nil {
+		lead = lead.next
+		trail = trail.next
+	}
+	return trail.value, nil
+}
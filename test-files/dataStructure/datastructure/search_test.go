@@ -0,0 +1,258 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIndexOf(t *testing.T) {
+	L := newListOf(5, 7, 9, 7, 11)
+	tests := []struct {
+		value int
+		want  int
+	}{
+		{5, 0},
+		{9, 2},
+		{11, 4},
+		{7, 1},
+		{42, -1},
+	}
+	for _, tt := range tests {
+		if got := IndexOf(L, tt.value); got != tt.want {
+			t.Errorf("IndexOf(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+	if got := IndexOf(NewLinkedList[int](), 1); got != -1 {
+		t.Errorf("IndexOf(empty) = %d, want -1", got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	L := newListOf("go", "ts", "rust")
+	for _, value := range []string{"go", "ts", "rust"} {
+		if !Contains(L, value) {
+			t.Errorf("Contains(%q) = false, want true", value)
+		}
+	}
+	if Contains(L, "java") {
+		t.Error(`Contains("java") = true, want false`)
+	}
+}
+
+func TestFind(t *testing.T) {
+	L := newListOf(4, 7, 8, 9)
+	calls := 0
+	value, index, ok := Find(L, func(v int) bool {
+		calls++
+		return v%2 == 0
+	})
+	if !ok || value != 4 || index != 0 {
+		t.Fatalf("Find = (%d, %d, %v), want (4, 0, true)", value, index, ok)
+	}
+	if calls != 1 {
+		t.Fatalf("匹配首元素后应停止遍历, calls = %d", calls)
+	}
+
+	value, index, ok = Find(L, func(v int) bool { return v > 7 })
+	if !ok || value != 8 || index != 2 {
+		t.Fatalf("Find = (%d, %d, %v), want (8, 2, true)", value, index, ok)
+	}
+
+	value, index, ok = Find(L, func(v int) bool { return v > 100 })
+	if ok || value != 0 || index != -1 {
+		t.Fatalf("Find = (%d, %d, %v), want (0, -1, false)", value, index, ok)
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5, 6)
+	if got, want := FindAll(L, func(v int) bool { return v%2 == 0 }), []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindAll = %v, want %v", got, want)
+	}
+	if got := FindAll(L, func(v int) bool { return v > 6 }); len(got) != 0 {
+		t.Fatalf("FindAll 无匹配时应为空, got %v", got)
+	}
+}
+
+func TestMiddle(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int
+	}{
+		{[]int{1}, 1},
+		{[]int{1, 2}, 2},
+		{[]int{1, 2, 3}, 2},
+		{[]int{1, 2, 3, 4}, 3},
+		{[]int{1, 2, 3, 4, 5}, 3},
+	}
+	for _, tt := range tests {
+		got, err := Middle(FromSlice(tt.values))
+		if err != nil || got != tt.want {
+			t.Errorf("Middle(%v) = (%d, %v), want (%d, nil)", tt.values, got, err, tt.want)
+		}
+	}
+	if _, err := Middle(NewLinkedList[int]()); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 Middle 应返回错误")
+	}
+}
+
+func TestNthFromEnd(t *testing.T) {
+	L := newListOf(10, 20, 30, 40)
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{1, 40},
+		{2, 30},
+		{4, 10},
+	}
+	for _, tt := range tests {
+		got, err := NthFromEnd(L, tt.n)
+		if err != nil || got != tt.want {
+			t.Errorf("NthFromEnd(%d) = (%d, %v), want (%d, nil)", tt.n, got, err, tt.want)
+		}
+	}
+	for _, n := range []int{0, -1, 5} {
+		if _, err := NthFromEnd(L, n); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("NthFromEnd(%d) 应返回错误", n)
+		}
+	}
+	if _, err := NthFromEnd(NewLinkedList[int](), 1); !errors.Is(err, ErrEmptyList) {
+		t.Error("空链表 NthFromEnd 应返回错误")
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldVal, newVal int
+		want           []int
+		count          int
+	}{
+		{"no match", 9, 0, []int{1, 2, 1, 3, 1}, 0},
+		{"single", 2, 20, []int{1, 20, 1, 3, 1}, 1},
+		{"multiple", 1, 7, []int{7, 2, 7, 3, 7}, 3},
+		{"same value", 1, 1, []int{1, 2, 1, 3, 1}, 3},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 1, 3, 1)
+		head, tail := L.head, L.tail
+		if got := ReplaceAll(L, tt.oldVal, tt.newVal); got != tt.count {
+			t.Errorf("%s: ReplaceAll = %d, want %d", tt.name, got, tt.count)
+		}
+		if got := ToSlice(L); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ToSlice = %v, want %v", tt.name, got, tt.want)
+		}
+		if L.head != head || L.tail != tail || Len(L) != 5 {
+			t.Errorf("%s: ReplaceAll 不应改变链表结构", tt.name)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	L := newListOf(4, 1, 4, 2, 4)
+	tests := []struct {
+		value int
+		want  int
+	}{
+		{9, 0},
+		{1, 1},
+		{2, 1},
+		{4, 3},
+	}
+	for _, tt := range tests {
+		if got := Count(L, tt.value); got != tt.want {
+			t.Errorf("Count(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+	if got := Count(NewLinkedList[int](), 1); got != 0 {
+		t.Errorf("Count(empty) = %d, want 0", got)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { Count(L, 4) }); allocs != 0 {
+		t.Errorf("Count 不应分配内存, allocs = %v", allocs)
+	}
+}
+
+func TestLastIndexOf(t *testing.T) {
+	L := newListOf(3, 1, 3, 2, 3)
+	tests := []struct {
+		value int
+		want  int
+	}{
+		{3, 4},
+		{1, 1},
+		{2, 3},
+		{9, -1},
+	}
+	for _, tt := range tests {
+		if got := LastIndexOf(L, tt.value); got != tt.want {
+			t.Errorf("LastIndexOf(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFindLast(t *testing.T) {
+	L := newListOf(2, 5, 4, 7, 6, 1)
+	value, index, ok := FindLast(L, func(v int) bool { return v%2 == 0 })
+	if !ok || value != 6 || index != 4 {
+		t.Fatalf("FindLast = (%d, %d, %v), want (6, 4, true)", value, index, ok)
+	}
+	value, index, ok = FindLast(L, func(v int) bool { return v == 5 })
+	if !ok || value != 5 || index != 1 {
+		t.Fatalf("FindLast = (%d, %d, %v), want (5, 1, true)", value, index, ok)
+	}
+	value, index, ok = FindLast(L, func(v int) bool { return v > 10 })
+	if ok || value != 0 || index != -1 {
+		t.Fatalf("FindLast = (%d, %d, %v), want (0, -1, false)", value, index, ok)
+	}
+}
+
+func TestAnyAllNone(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	tests := []struct {
+		name           string
+		L              *LinkedList[int]
+		any, all, none bool
+		count          int
+	}{
+		{"全部满足", newListOf(2, 4, 6), true, true, false, 3},
+		{"部分满足", newListOf(1, 2, 3), true, false, false, 1},
+		{"全部不满足", newListOf(1, 3, 5), false, false, true, 0},
+		{"空链表", NewLinkedList[int](), false, true, true, 0},
+	}
+	for _, tt := range tests {
+		if got := Any(tt.L, even); got != tt.any {
+			t.Errorf("%s: Any = %v, want %v", tt.name, got, tt.any)
+		}
+		if got := All(tt.L, even); got != tt.all {
+			t.Errorf("%s: All = %v, want %v", tt.name, got, tt.all)
+		}
+		if got := None(tt.L, even); got != tt.none {
+			t.Errorf("%s: None = %v, want %v", tt.name, got, tt.none)
+		}
+		if got := CountWhere(tt.L, even); got != tt.count {
+			t.Errorf("%s: CountWhere = %d, want %d", tt.name, got, tt.count)
+		}
+	}
+}
+
+func TestAnyAllShortCircuit(t *testing.T) {
+	L := newListOf(1, 2, 3, 4, 5)
+	calls := 0
+	if !Any(L, func(v int) bool { calls++; return v == 2 }) || calls != 2 {
+		t.Fatalf("Any 应在第一个满足的值处停止, calls = %d", calls)
+	}
+	calls = 0
+	if All(L, func(v int) bool { calls++; return v < 3 }) || calls != 3 {
+		t.Fatalf("All 应在第一个不满足的值处停止, calls = %d", calls)
+	}
+	calls = 0
+	if None(L, func(v int) bool { calls++; return v == 1 }) || calls != 1 {
+		t.Fatalf("None 应在第一个满足的值处停止, calls = %d", calls)
+	}
+	calls = 0
+	if CountWhere(L, func(v int) bool { calls++; return v > 2 }) != 3 || calls != 5 {
+		t.Fatalf("CountWhere 应遍历所有值, calls = %d", calls)
+	}
+}
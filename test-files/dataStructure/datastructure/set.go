@@ -0,0 +1,50 @@
+package datastructure
+
+// 以下集合运算借助 map 实现，时间复杂度 O(n+m)。结果均为新链表，
+// 按元素在 a（或 L）中第一次出现的顺序排列且不含重复值，不修改输入
+
+// toSet 返回 L 中所有值构成的集合
+func toSet[T comparable](L *LinkedList[T]) map[T]struct{} {
+	set := make(map[T]struct{}, L.length)
+	for current := L.head; current != nil; current = current.next {
+		set[current.value] = struct{}{}
+	}
+	return set
+}
+
+// collectUnique 按顺序保留 L 中满足 keep 的值，每个值只保留第一次出现
+func collectUnique[T comparable](L *LinkedList[T], keep func(T) bool) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	seen := make(map[T]struct{}, L.length)
+	for current := L.head; current != nil; current = current.next {
+		if _, ok := seen[current.value]; ok || !keep(current.value) {
+			continue
+		}
+		seen[current.value] = struct{}{}
+		PushBack(result, current.value)
+	}
+	return result
+}
+
+// Unique 返回去重后的新链表
+func Unique[T comparable](L *LinkedList[T]) *LinkedList[T] {
+	return collectUnique(L, func(T) bool { return true })
+}
+
+// Intersection 返回同时出现在 a 和 b 中的值
+func Intersection[T comparable](a, b *LinkedList[T]) *LinkedList[T] {
+	inB := toSet(b)
+	return collectUnique(a, func(v T) bool {
+		_, ok := inB[v]
+		return ok
+	})
+}
+
+// Difference 返回出现在 a 中但不在 b 中的值
+func Difference[T comparable](a, b *LinkedList[T]) *LinkedList[T] {
+	inB := toSet(b)
+	return collectUnique(a, func(v T) bool {
+		_, ok := inB[v]
+		return !ok
+	})
+}
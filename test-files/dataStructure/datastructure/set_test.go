@@ -0,0 +1,71 @@
+package datastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	L := newListOf(3, 1, 3, 2, 1)
+	u := Unique(L)
+	if got, want := ToSlice(u), []int{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Unique = %v, want %v", got, want)
+	}
+	validateLength(t, u)
+	if Len(L) != 5 {
+		t.Fatal("Unique 不应修改输入")
+	}
+
+	words := Unique(newListOf("b", "a", "b"))
+	if got, want := ToSlice(words), []string{"b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Unique = %v, want %v", got, want)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{"overlapping", []int{5, 1, 4, 2, 1}, []int{2, 9, 1}, []int{1, 2}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{}},
+		{"empty", []int{}, []int{1}, []int{}},
+	}
+	for _, tt := range tests {
+		got := Intersection(FromSlice(tt.a), FromSlice(tt.b))
+		if !reflect.DeepEqual(ToSlice(got), tt.want) {
+			t.Errorf("%s: Intersection = %v, want %v", tt.name, ToSlice(got), tt.want)
+		}
+		validateLength(t, got)
+	}
+
+	words := Intersection(newListOf("go", "ts", "rust"), newListOf("rust", "go"))
+	if got, want := ToSlice(words), []string{"go", "rust"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Intersection = %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{"overlapping", []int{5, 1, 4, 2, 5}, []int{2, 9, 1}, []int{5, 4}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{1, 2}},
+		{"subset", []int{1, 2}, []int{2, 1, 3}, []int{}},
+	}
+	for _, tt := range tests {
+		got := Difference(FromSlice(tt.a), FromSlice(tt.b))
+		if !reflect.DeepEqual(ToSlice(got), tt.want) {
+			t.Errorf("%s: Difference = %v, want %v", tt.name, ToSlice(got), tt.want)
+		}
+		validateLength(t, got)
+	}
+
+	words := Difference(newListOf("go", "ts", "rust"), newListOf("ts"))
+	if got, want := ToSlice(words), []string{"go", "rust"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Difference = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,183 @@
+package datastructure
+
+import (
+	"cmp"
+	"sort"
+)
+
+// InsertSorted 将 value 插入到第一个大于它的节点之前，已升序的链表插入后仍保持升序
+func InsertSorted[T cmp.Ordered](L *LinkedList[T], value T) {
+	defer invalidateIndex(L)
+	if L.head == nil || value < L.head.value {
+		PushFront(L, value)
+		return
+	}
+	current := L.head
+	for current.next != nil && current.next.value <= value {
+		current = current.next
+	}
+	newNode := &node[T]{value: value, next: current.next}
+	current.next = newNode
+	if current == L.tail {
+		L.tail = newNode
+	}
+	L.length++
+	enforceCapacity(L)
+}
+
+// IsSorted 判断链表是否从头到尾非递减，空链表与单元素链表视为有序
+func IsSorted[T cmp.Ordered](L *LinkedList[T]) bool {
+	return IsSortedFunc(L, func(a, b T) bool { return a < b })
+}
+
+// IsSortedFunc 判断链表是否按 less 有序，即不存在相邻的 a、b 满足 less(b, a)
+func IsSortedFunc[T any](L *LinkedList[T], less func(a, b T) bool) bool {
+	if L.head == nil {
+		return true
+	}
+	for current := L.head; current.next != nil; current = current.next {
+		if less(current.next.value, current.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort 使用归并排序将链表原地稳定升序排列
+func Sort[T cmp.Ordered](L *LinkedList[T]) {
+	SortFunc(L, func(a, b T) bool { return a < b })
+}
+
+// SortFunc 使用归并排序按 less 原地稳定排序，只重新链接节点，不拷贝到切片
+func SortFunc[T any](L *LinkedList[T], less func(a, b T) bool) {
+	defer invalidateIndex(L)
+	L.head = mergeSort(L.head, less)
+	L.tail = L.head
+	for L.tail != nil && L.tail.next != nil {
+		L.tail = L.tail.next
+	}
+}
+
+func mergeSort[T any](head *node[T], less func(a, b T) bool) *node[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+	slow, fast := head, head.next
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	right := slow.next
+	slow.next = nil
+	return mergeNodes(mergeSort(head, less), mergeSort(right, less), less)
+}
+
+// mergeNodes 合并两条有序节点链，相等时优先取 a 以保持稳定
+func mergeNodes[T any](a, b *node[T], less func(a, b T) bool) *node[T] {
+	var dummy node[T]
+	tail := &dummy
+	for a != nil && b != nil {
+		if less(b.value, a.value) {
+			tail.next = b
+			b = b.next
+		} else {
+			tail.next = a
+			a = a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	return dummy.next
+}
+
+// Merge 合并两个升序链表，返回新的升序链表，不修改输入
+func Merge[T cmp.Ordered](a, b *LinkedList[T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	x, y := a.head, b.head
+	for x != nil && y != nil {
+		if y.value < x.value {
+			PushBack(result, y.value)
+			y = y.next
+		} else {
+			PushBack(result, x.value)
+			x = x.next
+		}
+	}
+	for ; x != nil; x = x.next {
+		PushBack(result, x.value)
+	}
+	for ; y != nil; y = y.next {
+		PushBack(result, y.value)
+	}
+	return result
+}
+
+// Partition 原地将小于 pivot 的节点移到不小于 pivot 的节点之前，两组内部保持原有相对顺序，返回 L 本身
+func Partition[T cmp.Ordered](L *LinkedList[T], pivot T) *LinkedList[T] {
+	defer invalidateIndex(L)
+	var less, rest node[T]
+	lessTail, restTail := &less, &rest
+	for current := L.head; current != nil; current = current.next {
+		if current.value < pivot {
+			lessTail.next = current
+			lessTail = current
+		} else {
+			restTail.next = current
+			restTail = current
+		}
+	}
+	restTail.next = nil
+	lessTail.next = rest.next
+	L.head = less.next
+	if rest.next != nil {
+		L.tail = restTail
+	} else if lessTail != &less {
+		L.tail = lessTail
+	}
+	return L
+}
+
+// SortableView 将链表的值快照到切片中并实现 sort.Interface，排序后通过 Commit 写回链表。
+// 创建快照和写回各需要 O(n) 时间和 O(n) 额外空间
+type SortableView[T any] struct {
+	list   *LinkedList[T]
+	values []T
+	less   func(a, b T) bool
+}
+
+var _ sort.Interface = (*SortableView[int])(nil)
+
+// NewSortableView 创建按升序比较的视图
+func NewSortableView[T cmp.Ordered](L *LinkedList[T]) *SortableView[T] {
+	return NewSortableViewFunc(L, func(a, b T) bool { return a < b })
+}
+
+// NewSortableViewFunc 创建按 less 比较的视图
+func NewSortableViewFunc[T any](L *LinkedList[T], less func(a, b T) bool) *SortableView[T] {
+	return &SortableView[T]{list: L, values: ToSlice(L), less: less}
+}
+
+func (V *SortableView[T]) Len() int {
+	return len(V.values)
+}
+
+func (V *SortableView[T]) Less(i, j int) bool {
+	return V.less(V.values[i], V.values[j])
+}
+
+func (V *SortableView[T]) Swap(i, j int) {
+	V.values[i], V.values[j] = V.values[j], V.values[i]
+}
+
+// Commit 按快照中的顺序依次覆盖链表节点的值，链表结构保持不变
+func (V *SortableView[T]) Commit() {
+	i := 0
+	for current := V.list.head; current != nil && i < len(V.values); current = current.next {
+		current.value = V.values[i]
+		i++
+	}
+}
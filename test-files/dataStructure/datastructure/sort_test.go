@@ -0,0 +1,220 @@
+package datastructure
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestInsertSorted(t *testing.T) {
+	L := NewLinkedList[int]()
+	InsertSorted(L, 5)
+	if L.head != L.tail || L.head.value != 5 {
+		t.Fatalf("空链表插入后 head/tail 错误: %+v", L)
+	}
+	InsertSorted(L, 1)
+	if L.head.value != 1 {
+		t.Fatalf("新最小值应成为 head, head = %d", L.head.value)
+	}
+	InsertSorted(L, 9)
+	if L.tail.value != 9 {
+		t.Fatalf("新最大值应成为 tail, tail = %d", L.tail.value)
+	}
+	InsertSorted(L, 5)
+	InsertSorted(L, 3)
+	if got, want := ToSlice(L), []int{1, 3, 5, 5, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestInsertSortedShuffled(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := r.Perm(50)
+	for i := range values {
+		values[i] %= 20
+	}
+	L := NewLinkedList[int]()
+	for _, v := range values {
+		InsertSorted(L, v)
+	}
+	got := ToSlice(L)
+	if !slices.IsSorted(got) {
+		t.Fatalf("ToSlice 未升序: %v", got)
+	}
+	slices.Sort(values)
+	if !reflect.DeepEqual(got, values) {
+		t.Fatalf("ToSlice = %v, want %v", got, values)
+	}
+	validateLength(t, L)
+}
+
+func TestSort(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	tests := map[string][]int{
+		"random":   r.Perm(100),
+		"reversed": {9, 8, 7, 6, 5, 4, 3, 2, 1},
+		"sorted":   {1, 2, 3, 4, 5},
+		"single":   {42},
+		"empty":    {},
+		"repeated": {3, 1, 3, 1, 2},
+	}
+	for name, values := range tests {
+		L := FromSlice(values)
+		Sort(L)
+		want := slices.Clone(values)
+		slices.Sort(want)
+		if got := ToSlice(L); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: ToSlice = %v, want %v", name, got, want)
+		}
+		validateLength(t, L)
+		if len(want) > 0 && L.tail.value != want[len(want)-1] {
+			t.Errorf("%s: tail = %d, want %d", name, L.tail.value, want[len(want)-1])
+		}
+	}
+}
+
+func TestSortFuncDescending(t *testing.T) {
+	L := newListOf(3, 1, 4, 1, 5, 9, 2, 6)
+	SortFunc(L, func(a, b int) bool { return a > b })
+	if got, want := ToSlice(L), []int{9, 6, 5, 4, 3, 2, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestSortFuncStable(t *testing.T) {
+	L := newListOf(point{2, 0}, point{1, 0}, point{2, 1}, point{1, 1})
+	SortFunc(L, func(a, b point) bool { return a.X < b.X })
+	want := []point{{1, 0}, {1, 1}, {2, 0}, {2, 1}}
+	if got := ToSlice(L); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+	}{
+		{"different lengths", []int{1, 4, 9}, []int{2, 3, 5, 7, 11}},
+		{"overlapping", []int{1, 2, 2, 5}, []int{2, 5, 6}},
+		{"a empty", []int{}, []int{1, 2}},
+		{"b empty", []int{3, 4}, []int{}},
+		{"both empty", []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		a, b := FromSlice(tt.a), FromSlice(tt.b)
+		merged := Merge(a, b)
+		want := append(slices.Clone(tt.a), tt.b...)
+		slices.Sort(want)
+		if got := ToSlice(merged); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Merge = %v, want %v", tt.name, got, want)
+		}
+		if Len(merged) != len(tt.a)+len(tt.b) {
+			t.Errorf("%s: Len = %d, want %d", tt.name, Len(merged), len(tt.a)+len(tt.b))
+		}
+		validateLength(t, merged)
+		if !reflect.DeepEqual(ToSlice(a), tt.a) || !reflect.DeepEqual(ToSlice(b), tt.b) {
+			t.Errorf("%s: Merge 不应修改输入", tt.name)
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	L := newListOf(5, 1, 8, 3, 5, 2, 9, 4)
+	if got := Partition(L, 5); got != L {
+		t.Fatal("Partition 应返回原链表")
+	}
+	if got, want := ToSlice(L), []int{1, 3, 2, 4, 5, 8, 5, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestPartitionStable(t *testing.T) {
+	L := newListOf("d", "a", "x", "b", "y", "c")
+	Partition(L, "m")
+	if got, want := ToSlice(L), []string{"d", "a", "b", "c", "x", "y"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+}
+
+func TestPartitionOneSide(t *testing.T) {
+	tests := []struct {
+		name  string
+		pivot int
+	}{
+		{"all less", 100},
+		{"all greater or equal", 0},
+	}
+	for _, tt := range tests {
+		L := newListOf(3, 1, 2)
+		Partition(L, tt.pivot)
+		if got := ToSlice(L); !reflect.DeepEqual(got, []int{3, 1, 2}) {
+			t.Errorf("%s: ToSlice = %v, want [3 1 2]", tt.name, got)
+		}
+		validateLength(t, L)
+	}
+
+	empty := NewLinkedList[int]()
+	Partition(empty, 1)
+	validateLength(t, empty)
+}
+
+func TestSortableView(t *testing.T) {
+	L := newListOf(4, 2, 5, 1, 3)
+	view := NewSortableView(L)
+	sort.Sort(view)
+	if got := ToSlice(L); !reflect.DeepEqual(got, []int{4, 2, 5, 1, 3}) {
+		t.Fatalf("Commit 之前不应修改链表: %v", got)
+	}
+	view.Commit()
+	if got, want := ToSlice(L), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+	validateLength(t, L)
+}
+
+func TestSortableViewDescending(t *testing.T) {
+	L := newListOf("b", "c", "a")
+	view := NewSortableViewFunc(L, func(a, b string) bool { return a > b })
+	sort.Sort(view)
+	view.Commit()
+	if got, want := ToSlice(L), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice = %v, want %v", got, want)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	tests := []struct {
+		name      string
+		L         *LinkedList[int]
+		asc, desc bool
+	}{
+		{"升序", newListOf(1, 2, 3, 4), true, false},
+		{"降序", newListOf(4, 3, 2, 1), false, true},
+		{"相邻相等", newListOf(1, 2, 2, 3), true, false},
+		{"全部相等", newListOf(5, 5, 5), true, true},
+		{"无序", newListOf(1, 3, 2), false, false},
+		{"单个元素", newListOf(1), true, true},
+		{"空链表", NewLinkedList[int](), true, true},
+	}
+	for _, tt := range tests {
+		if got := IsSorted(tt.L); got != tt.asc {
+			t.Errorf("%s: IsSorted = %v, want %v", tt.name, got, tt.asc)
+		}
+		if got := IsSortedFunc(tt.L, greater); got != tt.desc {
+			t.Errorf("%s: IsSortedFunc(降序) = %v, want %v", tt.name, got, tt.desc)
+		}
+	}
+
+	L := newListOf(3, 1, 2)
+	Sort(L)
+	if !IsSorted(L) {
+		t.Fatalf("Sort 之后 IsSorted 应为 true: %v", L)
+	}
+}
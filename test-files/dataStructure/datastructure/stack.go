@@ -0,0 +1,36 @@
+package datastructure
+
+// Stack 基于单向链表的栈，所有操作都在头部进行，时间复杂度 O(1)
+type Stack[T any] struct {
+	list *LinkedList[T]
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{list: NewLinkedList[T]()}
+}
+
+func (S *Stack[T]) Push(value T) {
+	PushFront(S.list, value)
+}
+
+// Pop 弹出并返回栈顶元素，栈为空时返回 ErrEmptyList
+func (S *Stack[T]) Pop() (T, error) {
+	return PopFront(S.list)
+}
+
+// Peek 返回栈顶元素但不弹出，栈为空时返回 ErrEmptyList
+func (S *Stack[T]) Peek() (T, error) {
+	if S.list.head == nil {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return S.list.head.value, nil
+}
+
+func (S *Stack[T]) Len() int {
+	return Len(S.list)
+}
+
+func (S *Stack[T]) IsEmpty() bool {
+	return ListIsEmpty(S.list)
+}
@@ -0,0 +1,51 @@
+package datastructure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestStackLIFO(t *testing.T) {
+	S := NewStack[int]()
+	S.Push(1)
+	S.Push(2)
+	S.Push(3)
+	if v, err := S.Peek(); err != nil || v != 3 {
+		t.Fatalf("Peek = (%d, %v), want (3, nil)", v, err)
+	}
+	var popped []int
+	v, _ := S.Pop()
+	popped = append(popped, v)
+	S.Push(4)
+	for !S.IsEmpty() {
+		v, err := S.Pop()
+		if err != nil {
+			t.Fatalf("Pop 失败: %v", err)
+		}
+		popped = append(popped, v)
+	}
+	if want := []int{3, 4, 2, 1}; !reflect.DeepEqual(popped, want) {
+		t.Fatalf("弹出顺序 = %v, want %v", popped, want)
+	}
+	if S.Len() != 0 {
+		t.Fatalf("Len = %d, want 0", S.Len())
+	}
+}
+
+func TestStackEmpty(t *testing.T) {
+	S := NewStack[string]()
+	if !S.IsEmpty() || S.Len() != 0 {
+		t.Fatal("新建栈应为空")
+	}
+	if _, err := S.Pop(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("Pop(empty) err = %v, want ErrEmptyList", err)
+	}
+	if _, err := S.Peek(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("Peek(empty) err = %v, want ErrEmptyList", err)
+	}
+	S.Push("a")
+	if S.IsEmpty() || S.Len() != 1 {
+		t.Fatal("Push 后栈不应为空")
+	}
+}
@@ -0,0 +1,39 @@
+package datastructure
+
+import "cmp"
+
+// TopK 只保留目前为止最大的 k 个值。内部是容量为 k 的有界链表并保持升序，
+// 超出容量时有界链表从头部淘汰，恰好淘汰的是最小值
+type TopK[T cmp.Ordered] struct {
+	k    int
+	list *LinkedList[T]
+}
+
+// NewTopK 创建保留最大 k 个值的 TopK，k 不为正数时不保留任何值
+func NewTopK[T cmp.Ordered](k int) *TopK[T] {
+	return &TopK[T]{k: k, list: NewBoundedList[T](k)}
+}
+
+// Offer 按顺序插入 value，长度超过 k 时丢弃最小值
+func (K *TopK[T]) Offer(value T) {
+	if K.k <= 0 {
+		return
+	}
+	if K.list.length == K.k && value <= K.list.head.value {
+		return
+	}
+	InsertSorted(K.list, value)
+}
+
+// Values 按降序返回保留的值
+func (K *TopK[T]) Values() []T {
+	values := ToSlice(K.list)
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+	return values
+}
+
+func (K *TopK[T]) Len() int {
+	return Len(K.list)
+}
@@ -0,0 +1,63 @@
+package datastructure
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTopKRandomStream(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for _, k := range []int{1, 3, 10} {
+		values := make([]int, 200)
+		for i := range values {
+			values[i] = r.Intn(50)
+		}
+		K := NewTopK[int](k)
+		for _, v := range values {
+			K.Offer(v)
+		}
+		sorted := slices.Clone(values)
+		slices.Sort(sorted)
+		slices.Reverse(sorted)
+		if got, want := K.Values(), sorted[:k]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("k = %d: Values = %v, want %v", k, got, want)
+		}
+		validateLength(t, K.list)
+	}
+}
+
+func TestTopKTies(t *testing.T) {
+	K := NewTopK[int](3)
+	for _, v := range []int{5, 1, 5, 2, 5, 5, 3} {
+		K.Offer(v)
+	}
+	if got, want := K.Values(), []int{5, 5, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Values = %v, want %v", got, want)
+	}
+}
+
+func TestTopKFewerThanK(t *testing.T) {
+	K := NewTopK[int](5)
+	if got := K.Values(); len(got) != 0 {
+		t.Fatalf("空 TopK 的 Values = %v", got)
+	}
+	for _, v := range []int{2, 9, 4} {
+		K.Offer(v)
+	}
+	if got, want := K.Values(), []int{9, 4, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Values = %v, want %v", got, want)
+	}
+	if K.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", K.Len())
+	}
+}
+
+func TestTopKNonPositive(t *testing.T) {
+	K := NewTopK[int](0)
+	K.Offer(1)
+	if K.Len() != 0 {
+		t.Fatalf("k 为 0 时不应保留任何值, Len = %d", K.Len())
+	}
+}
@@ -0,0 +1,34 @@
+package datastructure
+
+import "fmt"
+
+// Validate 检查链表的结构不变量：非空链表的 head 不为 nil、节点数等于 length、
+// 最后一个可达节点就是 tail。不变量被破坏时返回包装了 ErrCorruptedList 的错误，
+// 错误信息指明具体失败的不变量。遍历最多走 length+1 步，因此对成环的链表也会终止
+func Validate[T any](L *LinkedList[T]) error {
+	if L.length < 0 {
+		return fmt.Errorf("%w: length 为负数 %d", ErrCorruptedList, L.length)
+	}
+	if L.length > 0 && L.head == nil {
+		return fmt.Errorf("%w: length 为 %d 但 head 为 nil", ErrCorruptedList, L.length)
+	}
+	if L.length == 0 && (L.head != nil || L.tail != nil) {
+		return fmt.Errorf("%w: length 为 0 但 head 或 tail 不为 nil", ErrCorruptedList)
+	}
+	count := 0
+	var last *node[T]
+	for current := L.head; current != nil; current = current.next {
+		count++
+		if count > L.length {
+			return fmt.Errorf("%w: 可达节点数超过 length %d，可能存在环", ErrCorruptedList, L.length)
+		}
+		last = current
+	}
+	if count != L.length {
+		return fmt.Errorf("%w: 可达节点数 %d 与 length %d 不一致", ErrCorruptedList, count, L.length)
+	}
+	if last != L.tail {
+		return fmt.Errorf("%w: 最后一个可达节点不是 tail", ErrCorruptedList)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package datastructure
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateHealthy(t *testing.T) {
+	tests := []*LinkedList[int]{
+		NewLinkedList[int](),
+		newListOf(1),
+		newListOf(1, 2, 3),
+	}
+	for _, L := range tests {
+		if err := Validate(L); err != nil {
+			t.Errorf("Validate(%v) = %v, want nil", L, err)
+		}
+	}
+}
+
+func TestValidateCorrupted(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(L *LinkedList[int])
+	}{
+		{"nil head", func(L *LinkedList[int]) { L.head = nil }},
+		{"length too large", func(L *LinkedList[int]) { L.length++ }},
+		{"length too small", func(L *LinkedList[int]) { L.length-- }},
+		{"negative length", func(L *LinkedList[int]) { L.length = -1 }},
+		{"zero length with nodes", func(L *LinkedList[int]) { L.length = 0 }},
+		{"stale tail", func(L *LinkedList[int]) { L.tail = L.head }},
+		{"nil tail", func(L *LinkedList[int]) { L.tail = nil }},
+		{"cycle", func(L *LinkedList[int]) { L.tail.next = L.head }},
+	}
+	for _, tt := range tests {
+		L := newListOf(1, 2, 3)
+		tt.corrupt(L)
+		err := Validate(L)
+		if !errors.Is(err, ErrCorruptedList) {
+			t.Errorf("%s: Validate = %v, want ErrCorruptedList", tt.name, err)
+			continue
+		}
+		t.Logf("%s: %v", tt.name, err)
+	}
+}
+
+func TestValidateStaleEmptyTail(t *testing.T) {
+	L := NewLinkedList[int]()
+	L.tail = &node[int]{value: 1}
+	if err := Validate(L); !errors.Is(err, ErrCorruptedList) {
+		t.Fatalf("Validate = %v, want ErrCorruptedList", err)
+	}
+}